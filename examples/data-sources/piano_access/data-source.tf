@@ -0,0 +1,5 @@
+data "piano_access" "example" {
+  aid   = "AIDXXXXXXX"
+  email = "user@example.com"
+  rid   = "RXXXXXX"
+}
@@ -1,3 +1,3 @@
 data "piano_app" "example" {
-  aid = "example"
+  aid = "AIDXXXXXXX"
 }
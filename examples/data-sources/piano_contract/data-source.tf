@@ -1,4 +1,4 @@
 data "piano_contract" "example" {
-  aid         = "example-aid"
+  aid         = "AIDXXXXXXX"
   contract_id = "example"
 }
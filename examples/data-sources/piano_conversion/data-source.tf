@@ -0,0 +1,6 @@
+data "piano_conversion" "example" {
+  aid       = "AIDXXXXXXX"
+  term_id   = "TMXXXXXXXXXX"
+  date_from = 1735657200
+  limit     = 1000
+}
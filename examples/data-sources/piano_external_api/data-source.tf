@@ -0,0 +1,4 @@
+data "piano_external_api" "example" {
+  aid             = "AIDXXXXXXX"
+  external_api_id = "XXXXXXXXXX"
+}
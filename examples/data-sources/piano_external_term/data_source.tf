@@ -1,4 +1,4 @@
 data "piano_external_term" "sample" {
-  aid     = "sample-aid"
+  aid     = "AIDXXXXXXX"
   term_id = "TMA1B2CD34EF"
 }
@@ -1,4 +1,4 @@
 data "piano_licensee" "example" {
-  aid         = "example"
+  aid         = "AIDXXXXXXX"
   licensee_id = "example"
 }
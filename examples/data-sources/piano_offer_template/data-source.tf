@@ -0,0 +1,4 @@
+data "piano_offer_template" "example" {
+  aid               = "AIDXXXXXXX"
+  offer_template_id = "OTXXXXXXXXXX"
+}
@@ -0,0 +1,4 @@
+data "piano_offer_templates" "example" {
+  aid    = "AIDXXXXXXX"
+  status = "active"
+}
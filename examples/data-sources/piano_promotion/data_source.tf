@@ -1,5 +1,5 @@
 
 data "piano_promotion" "sample" {
-  aid          = "sample-aid"
+  aid          = "AIDXXXXXXX"
   promotion_id = "sample-promotion-id"
 }
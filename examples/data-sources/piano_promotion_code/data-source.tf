@@ -0,0 +1,4 @@
+data "piano_promotion_code" "example" {
+  aid  = "AIDXXXXXXX"
+  code = "SPRING2025"
+}
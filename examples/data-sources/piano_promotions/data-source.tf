@@ -0,0 +1,4 @@
+data "piano_promotions" "example" {
+  aid   = "AIDXXXXXXX"
+  state = "active"
+}
@@ -1,4 +1,4 @@
 data "piano_resource" "example" {
-  aid = "example-aid"
+  aid = "AIDXXXXXXX"
   rid = "example-rid"
 }
@@ -0,0 +1,5 @@
+data "piano_resources" "example" {
+  aid      = "AIDXXXXXXX"
+  type     = "standard"
+  disabled = false
+}
@@ -0,0 +1,4 @@
+data "piano_term_change_options" "example" {
+  aid     = "AIDXXXXXXX"
+  term_id = "TMXXXXXXXXXX"
+}
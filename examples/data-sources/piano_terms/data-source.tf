@@ -0,0 +1,5 @@
+data "piano_terms" "example" {
+  aid  = "AIDXXXXXXX"
+  rid  = "RXXXXXXX"
+  type = "payment"
+}
@@ -0,0 +1,4 @@
+data "piano_user" "example" {
+  aid   = "AIDXXXXXXX"
+  email = "user@example.com"
+}
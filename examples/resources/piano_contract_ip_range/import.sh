@@ -0,0 +1 @@
+terraform import piano_contract_ip_range.office "example-aid/example-contract-id/example-contract-ip-range-id"
@@ -0,0 +1,15 @@
+resource "piano_contract" "example" {
+  aid                      = "AIDXXXXXXX"
+  licensee_id              = "example-licensee-id"
+  rid                      = "example-rid"
+  contract_type            = "IP_RANGE_CONTRACT"
+  name                     = "Example IP Range Contract"
+  seats_number             = 100
+  is_hard_seats_limit_type = true
+}
+
+resource "piano_contract_ip_range" "office" {
+  aid         = piano_contract.example.aid
+  contract_id = piano_contract.example.contract_id
+  ip_range    = "192.0.2.0/24"
+}
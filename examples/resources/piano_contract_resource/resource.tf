@@ -1,5 +1,5 @@
 resource "piano_contract" "example" {
-  aid                      = "example-aid"
+  aid                      = "AIDXXXXXXX"
   licensee_id              = "example-licensee-id"
   rid                      = "example-rid"
   contract_type            = "EMAIL_DOMAIN_CONTRACT"
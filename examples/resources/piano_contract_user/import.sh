@@ -0,0 +1 @@
+terraform import piano_contract_user.member "example-aid/example-contract-id/example-contract-user-id"
@@ -0,0 +1,17 @@
+resource "piano_contract" "example" {
+  aid                      = "AIDXXXXXXX"
+  licensee_id              = "example-licensee-id"
+  rid                      = "example-rid"
+  contract_type            = "SPECIFIC_EMAIL_ADDRESSES_CONTRACT"
+  name                     = "Example Email Addresses Contract"
+  seats_number             = 100
+  is_hard_seats_limit_type = true
+}
+
+resource "piano_contract_user" "member" {
+  aid         = piano_contract.example.aid
+  contract_id = piano_contract.example.contract_id
+  email       = "member@example.com"
+  first_name  = "Example"
+  last_name   = "Member"
+}
@@ -1,5 +1,5 @@
 resource "piano_external_term" "sample" {
-  aid = "sample-aid"
+  aid = "AIDXXXXXXX"
   resource = {
     rid = "sample-rid"
   }
@@ -0,0 +1 @@
+terraform import piano_grant_access.vip sample-aid/sample-rid/sample-uid/sample-access-id
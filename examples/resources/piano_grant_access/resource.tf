@@ -0,0 +1,6 @@
+resource "piano_grant_access" "vip" {
+  aid         = "AIDXXXXXXX"
+  rid         = "RXXXXXX"
+  uid         = "UXXXXXX"
+  expire_date = 1767193200
+}
@@ -1,5 +1,5 @@
 resource "piano_licensee" "example" {
-  aid         = "example"
+  aid         = "AIDXXXXXXX"
   name        = "example"
   description = "example piano licensee"
   managers = [
@@ -0,0 +1 @@
+terraform import piano_linked_term.app_store "sample-aid/linked-term-id"
@@ -0,0 +1,10 @@
+resource "piano_linked_term" "app_store" {
+  aid  = "AIDXXXXXXX"
+  rid  = "sample-rid"
+  name = "App Store Subscription"
+  external_product_ids = [
+    "digital_prod",
+    "print_sub_access",
+  ]
+  subscription_management_url = "https://apps.apple.com/account/subscriptions"
+}
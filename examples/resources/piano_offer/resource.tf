@@ -1,23 +1,23 @@
 
 resource "piano_offer" "sample" {
   name = "Sample"
-  aid  = "sample-aid"
+  aid  = "AIDXXXXXXX"
 }
 
 resource "piano_offer_term_binding" "term_a_in_sample_offer" {
-  aid      = "sample-aid"
+  aid      = "AIDXXXXXXX"
   offer_id = piano_offer.sample.offer_id
   term_id  = "term-a-id"
 }
 
 resource "piano_offer_term_binding" "term_b_in_sample_offer" {
-  aid      = "sample-aid"
+  aid      = "AIDXXXXXXX"
   offer_id = piano_offer.sample.offer_id
   term_id  = "term-b-id"
 }
 
 resource "piano_offer_term_order" "term_order_in_sample_offer" {
-  aid      = "sample-aid"
+  aid      = "AIDXXXXXXX"
   offer_id = piano_offer.sample.offer_id
   term_ids = [
     piano_offer_term_binding.term_b_in_sample_offer.term_id,
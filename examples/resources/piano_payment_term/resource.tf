@@ -1,5 +1,5 @@
 resource "piano_payment_term" "sample" {
-  aid  = "sample-aid"
+  aid  = "AIDXXXXXXX"
   name = "Sample Payment Term"
   schedule = {
     schedule_id = "sample-schedule-id"
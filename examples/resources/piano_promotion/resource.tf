@@ -1,7 +1,7 @@
 
 
 resource "piano_promotion" "sample" {
-  aid  = "sample-aid"
+  aid  = "AIDXXXXXXX"
   name = "sample"
   # null indicates unlimited uses
   uses_allowed = null
@@ -1,4 +1,4 @@
 resource "piano_resource" "sample" {
-  aid  = "sample-aid"
+  aid  = "AIDXXXXXXX"
   name = "Sample"
 }
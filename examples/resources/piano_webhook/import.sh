@@ -0,0 +1 @@
+terraform import piano_webhook.example sample-aid
@@ -0,0 +1,9 @@
+resource "piano_webhook" "example" {
+  aid = "AIDXXXXXXX"
+  url = "https://example.com/piano/webhook"
+  event_types = [
+    "new_purchase",
+    "access_revoked",
+    "subscription_canceled",
+  ]
+}
@@ -9,6 +9,7 @@ require (
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 	github.com/hashicorp/terraform-plugin-testing v1.12.0
 	github.com/oapi-codegen/runtime v1.1.1
+	golang.org/x/time v0.11.0
 )
 
 require (
@@ -8,15 +8,24 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"slices"
 	"strings"
 )
 
+// nonJSONBodyLimit is the maximum number of bytes of a non-JSON body included in an error.
+const nonJSONBodyLimit = 200
+
 type AnyResponse struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode       int               `json:"-"`
 	Code             int               `json:"code"`
 	Message          *string           `json:"message"`
 	ValidationErrors *ValidationErrors `json:"validation_errors"`
-	Raw              json.RawMessage   `json:"-"`
+	// Total is the total number of items of list endpoints
+	Total *int            `json:"total"`
+	Raw   json.RawMessage `json:"-"`
 }
 
 type ValidationErrors struct {
@@ -53,36 +62,128 @@ func ValidPianoEndpoint(input string) bool {
 	return exist
 }
 
-func AnyResponseFrom(response *http.Response) (*AnyResponse, error) {
+// AnyResponseFrom decodes the body of a piano.io response as AnyResponse.
+//
+// It tolerates non-zero codes so that callers can handle specific error codes, e.g. a term that no longer exists.
+// Callers are expected to check Code themselves and report other codes with StatusErrorSummary.
+// Failures to read or decode the body are reported to onError.
+func AnyResponseFrom(response *http.Response, onError func(summary string, detail string)) (*AnyResponse, error) {
 	defer response.Body.Close()
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
+		onError("IO Error", fmt.Sprintf("Unable to read body, got error: %s", err))
+		return nil, err
+	}
+	if err := nonJSONResponseError(response, body); err != nil {
+		onError("Upstream Error", err.Error())
 		return nil, err
 	}
 	anyResponse := AnyResponse{}
 	err = json.Unmarshal(body, &anyResponse)
 	if err != nil {
+		onError("Decode Error", fmt.Sprintf("Unable to decode body as AnyResponse, got error: %s", err))
 		return nil, err
 	}
-	return &anyResponse, err
+	anyResponse.StatusCode = response.StatusCode
+	return &anyResponse, nil
 }
 
+// SuccessfulResponseFrom decodes the body of a piano.io response as AnyResponse and requires it to be successful,
+// that is HTTP status 200 and code 0. Any other response is reported to onError.
 func SuccessfulResponseFrom(response *http.Response, onError func(summary string, detail string)) (*AnyResponse, error) {
-	defer response.Body.Close()
-	body, err := io.ReadAll(response.Body)
+	anyResponse, err := AnyResponseFrom(response, onError)
 	if err != nil {
-		onError("IO Error", fmt.Sprintf("Unable to read body, got error: %e", err))
-		return nil, err
-	}
-	anyResponse := AnyResponse{}
-	err = json.Unmarshal(body, &anyResponse)
-	if err != nil {
-		onError("Decode Error", fmt.Sprintf("Unable to decode body as AnyResponse, got error: %e", err))
 		return nil, err
 	}
 	if anyResponse.Code != 0 {
-		onError(fmt.Sprintf("Status Error: %d: %s", anyResponse.Code, *anyResponse.Message), string(anyResponse.Raw))
-		return nil, errors.New("status error")
+		onError(anyResponse.StatusErrorSummary(), string(anyResponse.Raw))
+		return nil, anyResponse.Err()
+	}
+	if response.StatusCode != http.StatusOK {
+		onError(fmt.Sprintf("Status Error: HTTP %d", response.StatusCode), string(anyResponse.Raw))
+		return nil, anyResponse.Err()
+	}
+	return anyResponse, nil
+}
+
+// Err returns an *APIError describing an unsuccessful response, or nil when the response is successful.
+func (res *AnyResponse) Err() error {
+	if res.Code == 0 && (res.StatusCode == 0 || res.StatusCode == http.StatusOK) {
+		return nil
+	}
+	message := ""
+	if res.Message != nil {
+		message = *res.Message
+	}
+	return &APIError{StatusCode: res.StatusCode, Code: res.Code, Message: message}
+}
+
+// StatusErrorSummary formats the code and message of an unsuccessful response as a diagnostic summary.
+func (res *AnyResponse) StatusErrorSummary() string {
+	message := ""
+	if res.Message != nil {
+		message = *res.Message
+	}
+	return fmt.Sprintf("Status Error: %d: %s", res.Code, message)
+}
+
+// nonJSONResponseError reports a response that is not JSON such as an HTML error page returned by a proxy during piano.io outages.
+// Decoding such a body fails with a confusing error like "invalid character '<'", so it is detected before decoding.
+func nonJSONResponseError(response *http.Response, body []byte) error {
+	mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	trimmed := strings.TrimSpace(string(body))
+	if mediaType != "text/html" && !strings.HasPrefix(trimmed, "<") {
+		return nil
+	}
+	if len(trimmed) > nonJSONBodyLimit {
+		trimmed = trimmed[:nonJSONBodyLimit] + "..."
+	}
+	return fmt.Errorf("upstream returned non-JSON response (HTTP status %d): %s", response.StatusCode, trimmed)
+}
+
+var (
+	// ErrNotFound matches an *APIError reporting that the requested object does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited matches an *APIError reporting that piano.io API throttled the request.
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// notFoundCodes are the codes piano.io API returns when the requested object does not exist.
+var notFoundCodes = []int{
+	404,   // Not found
+	1001,  // Term not found
+	1012,  // Term's change option not found
+	2004,  // User not found
+	8005,  // Subscription not found
+	61002, // Contract not found
+	61005, // Licensee not found
+	61011, // Schedule not found
+	61028, // Contract domain not found
+	61035, // Contract ip range not found
+}
+
+// APIError is an unsuccessful response of piano.io API.
+// Use errors.Is with ErrNotFound or ErrRateLimited to classify it, or errors.As to branch on a specific code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Code is the error code in the response body. Note that piano.io API may return a non-zero code with HTTP status 200.
+	Code int
+	// Message is the error message in the response body
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("piano.io API error (HTTP status %d, code %d): %s", e.StatusCode, e.Code, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || slices.Contains(notFoundCodes, e.Code)
+	case ErrRateLimited:
+		// code 429 means "Term name is empty" in piano.io API, so only the HTTP status is relevant.
+		return e.StatusCode == http.StatusTooManyRequests
 	}
-	return &anyResponse, err
+	return false
 }
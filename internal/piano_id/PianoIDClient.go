@@ -12,6 +12,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/oapi-codegen/runtime"
 )
 
 // Defines values for CustomFieldAttributeDateFormat.
@@ -110,6 +112,15 @@ type CustomFieldDefinitionDataType string
 // CustomFieldDefinitionFavouriteOptions defines model for CustomFieldDefinition.FavouriteOptions.
 type CustomFieldDefinitionFavouriteOptions string
 
+// CustomFieldValue defines model for CustomFieldValue.
+type CustomFieldValue struct {
+	// FieldName The field name of the custom field
+	FieldName string `json:"field_name"`
+
+	// Value The value of the custom field
+	Value *string `json:"value"`
+}
+
 // PianoIDErrorDetail defines model for PianoIDErrorDetail.
 type PianoIDErrorDetail struct {
 	ErrorCodeList []PianoIDErrorDetailItem `json:"error_code_list"`
@@ -126,6 +137,31 @@ type PostPublisherCustomFieldRequest = []CustomFieldDefinition
 // PostPublisherCustomFieldResponse defines model for PostPublisherCustomFieldResponse.
 type PostPublisherCustomFieldResponse = []CustomFieldDefinition
 
+// PublisherUser defines model for PublisherUser.
+type PublisherUser struct {
+	// CreateDate The user creation date
+	CreateDate   *int64             `json:"create_date"`
+	CustomFields []CustomFieldValue `json:"custom_fields"`
+
+	// Email The user email
+	Email string `json:"email"`
+
+	// EmailConfirmed Whether or not the user email is confirmed
+	EmailConfirmed bool `json:"email_confirmed"`
+
+	// FirstName The user first name
+	FirstName *string `json:"first_name"`
+
+	// LastName The user last name
+	LastName *string `json:"last_name"`
+
+	// PersonalName The user personal name
+	PersonalName *string `json:"personal_name"`
+
+	// Uid The user ID
+	Uid string `json:"uid"`
+}
+
 // Tooltip defines model for Tooltip.
 type Tooltip struct {
 	Enabled *bool   `json:"enabled,omitempty"`
@@ -165,6 +201,18 @@ type ValidatorParameter struct {
 	Whitelist *[]string `json:"whitelist,omitempty"`
 }
 
+// PublisherUsersGetParams defines parameters for PublisherUsersGet.
+type PublisherUsersGetParams struct {
+	// Aid The application ID
+	Aid string `form:"aid" json:"aid"`
+
+	// Uid The user ID
+	Uid *string `form:"uid,omitempty" json:"uid,omitempty"`
+
+	// Email The user email
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+}
+
 // PublisherCustomFieldPostJSONRequestBody defines body for PublisherCustomFieldPost for application/json ContentType.
 type PublisherCustomFieldPostJSONRequestBody = PostPublisherCustomFieldRequest
 
@@ -245,6 +293,9 @@ type ClientInterface interface {
 	PublisherCustomFieldPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PublisherCustomFieldPost(ctx context.Context, body PublisherCustomFieldPostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublisherUsersGet request
+	PublisherUsersGet(ctx context.Context, params *PublisherUsersGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) PublisherCustomFieldPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -271,6 +322,18 @@ func (c *Client) PublisherCustomFieldPost(ctx context.Context, body PublisherCus
 	return c.Client.Do(req)
 }
 
+func (c *Client) PublisherUsersGet(ctx context.Context, params *PublisherUsersGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublisherUsersGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewPublisherCustomFieldPostRequest calls the generic PublisherCustomFieldPost builder with application/json body
 func NewPublisherCustomFieldPostRequest(server string, body PublisherCustomFieldPostJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -311,6 +374,83 @@ func NewPublisherCustomFieldPostRequestWithBody(server string, contentType strin
 	return req, nil
 }
 
+// NewPublisherUsersGetRequest generates requests for PublisherUsersGet
+func NewPublisherUsersGetRequest(server string, params *PublisherUsersGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/publisher/users/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aid", runtime.ParamLocationQuery, params.Aid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Uid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uid", runtime.ParamLocationQuery, *params.Uid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Email != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -358,6 +498,9 @@ type ClientWithResponsesInterface interface {
 	PublisherCustomFieldPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublisherCustomFieldPostResponse, error)
 
 	PublisherCustomFieldPostWithResponse(ctx context.Context, body PublisherCustomFieldPostJSONRequestBody, reqEditors ...RequestEditorFn) (*PublisherCustomFieldPostResponse, error)
+
+	// PublisherUsersGetWithResponse request
+	PublisherUsersGetWithResponse(ctx context.Context, params *PublisherUsersGetParams, reqEditors ...RequestEditorFn) (*PublisherUsersGetResponse, error)
 }
 
 type PublisherCustomFieldPostResponse struct {
@@ -383,6 +526,29 @@ func (r PublisherCustomFieldPostResponse) StatusCode() int {
 	return 0
 }
 
+type PublisherUsersGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublisherUser
+	JSONDefault  *PianoIDErrorDetail
+}
+
+// Status returns HTTPResponse.Status
+func (r PublisherUsersGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PublisherUsersGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // PublisherCustomFieldPostWithBodyWithResponse request with arbitrary body returning *PublisherCustomFieldPostResponse
 func (c *ClientWithResponses) PublisherCustomFieldPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublisherCustomFieldPostResponse, error) {
 	rsp, err := c.PublisherCustomFieldPostWithBody(ctx, contentType, body, reqEditors...)
@@ -400,6 +566,15 @@ func (c *ClientWithResponses) PublisherCustomFieldPostWithResponse(ctx context.C
 	return ParsePublisherCustomFieldPostResponse(rsp)
 }
 
+// PublisherUsersGetWithResponse request returning *PublisherUsersGetResponse
+func (c *ClientWithResponses) PublisherUsersGetWithResponse(ctx context.Context, params *PublisherUsersGetParams, reqEditors ...RequestEditorFn) (*PublisherUsersGetResponse, error) {
+	rsp, err := c.PublisherUsersGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublisherUsersGetResponse(rsp)
+}
+
 // ParsePublisherCustomFieldPostResponse parses an HTTP response from a PublisherCustomFieldPostWithResponse call
 func ParsePublisherCustomFieldPostResponse(rsp *http.Response) (*PublisherCustomFieldPostResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -432,3 +607,36 @@ func ParsePublisherCustomFieldPostResponse(rsp *http.Response) (*PublisherCustom
 
 	return response, nil
 }
+
+// ParsePublisherUsersGetResponse parses an HTTP response from a PublisherUsersGetWithResponse call
+func ParsePublisherUsersGetResponse(rsp *http.Response) (*PublisherUsersGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PublisherUsersGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublisherUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest PianoIDErrorDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -670,6 +670,12 @@ const (
 	GetPublisherTermGetErrorCodeN2    GetPublisherTermGetErrorCode = 2
 )
 
+// Defines values for GetPublisherTermListErrorCode.
+const (
+	GetPublisherTermListErrorCodeN1001 GetPublisherTermListErrorCode = 1001
+	GetPublisherTermListErrorCodeN2    GetPublisherTermListErrorCode = 2
+)
+
 // Defines values for GetPublisherTermStatsListErrorCode.
 const (
 	GetPublisherTermStatsListErrorCodeN16001 GetPublisherTermStatsListErrorCode = 16001
@@ -4886,6 +4892,74 @@ const (
 	Vestdb             GetPublisherTermCountParamsSource = "vestdb"
 )
 
+// Defines values for GetPublisherTermListParamsIncludeType.
+const (
+	GetPublisherTermListParamsIncludeTypeAdview                         GetPublisherTermListParamsIncludeType = "adview"
+	GetPublisherTermListParamsIncludeTypeCustom                         GetPublisherTermListParamsIncludeType = "custom"
+	GetPublisherTermListParamsIncludeTypeDynamic                        GetPublisherTermListParamsIncludeType = "dynamic"
+	GetPublisherTermListParamsIncludeTypeEmailDomainContract            GetPublisherTermListParamsIncludeType = "email_domain_contract"
+	GetPublisherTermListParamsIncludeTypeExternal                       GetPublisherTermListParamsIncludeType = "external"
+	GetPublisherTermListParamsIncludeTypeGift                           GetPublisherTermListParamsIncludeType = "gift"
+	GetPublisherTermListParamsIncludeTypeGrantAccess                    GetPublisherTermListParamsIncludeType = "grant_access"
+	GetPublisherTermListParamsIncludeTypeIpRangeContract                GetPublisherTermListParamsIncludeType = "ip_range_contract"
+	GetPublisherTermListParamsIncludeTypeLinked                         GetPublisherTermListParamsIncludeType = "linked"
+	GetPublisherTermListParamsIncludeTypeNewsletter                     GetPublisherTermListParamsIncludeType = "newsletter"
+	GetPublisherTermListParamsIncludeTypePayment                        GetPublisherTermListParamsIncludeType = "payment"
+	GetPublisherTermListParamsIncludeTypeRegistration                   GetPublisherTermListParamsIncludeType = "registration"
+	GetPublisherTermListParamsIncludeTypeSpecificEmailAddressesContract GetPublisherTermListParamsIncludeType = "specific_email_addresses_contract"
+)
+
+// Defines values for GetPublisherTermListParamsExcludeType.
+const (
+	GetPublisherTermListParamsExcludeTypeAdview                         GetPublisherTermListParamsExcludeType = "adview"
+	GetPublisherTermListParamsExcludeTypeCustom                         GetPublisherTermListParamsExcludeType = "custom"
+	GetPublisherTermListParamsExcludeTypeDynamic                        GetPublisherTermListParamsExcludeType = "dynamic"
+	GetPublisherTermListParamsExcludeTypeEmailDomainContract            GetPublisherTermListParamsExcludeType = "email_domain_contract"
+	GetPublisherTermListParamsExcludeTypeExternal                       GetPublisherTermListParamsExcludeType = "external"
+	GetPublisherTermListParamsExcludeTypeGift                           GetPublisherTermListParamsExcludeType = "gift"
+	GetPublisherTermListParamsExcludeTypeGrantAccess                    GetPublisherTermListParamsExcludeType = "grant_access"
+	GetPublisherTermListParamsExcludeTypeIpRangeContract                GetPublisherTermListParamsExcludeType = "ip_range_contract"
+	GetPublisherTermListParamsExcludeTypeLinked                         GetPublisherTermListParamsExcludeType = "linked"
+	GetPublisherTermListParamsExcludeTypeNewsletter                     GetPublisherTermListParamsExcludeType = "newsletter"
+	GetPublisherTermListParamsExcludeTypePayment                        GetPublisherTermListParamsExcludeType = "payment"
+	GetPublisherTermListParamsExcludeTypeRegistration                   GetPublisherTermListParamsExcludeType = "registration"
+	GetPublisherTermListParamsExcludeTypeSpecificEmailAddressesContract GetPublisherTermListParamsExcludeType = "specific_email_addresses_contract"
+)
+
+// Defines values for GetPublisherTermListParamsResourceType.
+const (
+	GetPublisherTermListParamsResourceTypeBundle   GetPublisherTermListParamsResourceType = "bundle"
+	GetPublisherTermListParamsResourceTypePrint    GetPublisherTermListParamsResourceType = "print"
+	GetPublisherTermListParamsResourceTypeStandard GetPublisherTermListParamsResourceType = "standard"
+)
+
+// Defines values for GetPublisherTermListParamsSource.
+const (
+	GetPublisherTermListParamsSourceAbril              GetPublisherTermListParamsSource = "abril"
+	GetPublisherTermListParamsSourceAbrilAddress       GetPublisherTermListParamsSource = "abril_address"
+	GetPublisherTermListParamsSourceAppleItunes        GetPublisherTermListParamsSource = "apple_itunes"
+	GetPublisherTermListParamsSourceCds                GetPublisherTermListParamsSource = "cds"
+	GetPublisherTermListParamsSourceGooglePlay         GetPublisherTermListParamsSource = "google_play"
+	GetPublisherTermListParamsSourceNewscycle          GetPublisherTermListParamsSource = "newscycle"
+	GetPublisherTermListParamsSourcePaypalSubscription GetPublisherTermListParamsSource = "paypal_subscription"
+	GetPublisherTermListParamsSourcePscProvider        GetPublisherTermListParamsSource = "psc_provider"
+	GetPublisherTermListParamsSourceSwg                GetPublisherTermListParamsSource = "swg"
+	GetPublisherTermListParamsSourceVestdb             GetPublisherTermListParamsSource = "vestdb"
+)
+
+// Defines values for GetPublisherTermListParamsOrderBy.
+const (
+	GetPublisherTermListParamsOrderByResourceName GetPublisherTermListParamsOrderBy = "resource_name"
+	GetPublisherTermListParamsOrderByResourceType GetPublisherTermListParamsOrderBy = "resource_type"
+	GetPublisherTermListParamsOrderByTermName     GetPublisherTermListParamsOrderBy = "term_name"
+)
+
+// Defines values for GetPublisherTermListParamsOrderDirection.
+const (
+	GetPublisherTermListParamsOrderDirectionAsc  GetPublisherTermListParamsOrderDirection = "asc"
+	GetPublisherTermListParamsOrderDirectionDesc GetPublisherTermListParamsOrderDirection = "desc"
+)
+
 // Defines values for GetPublisherUserEmailListParamsOrderBy.
 const (
 	DateCreated GetPublisherUserEmailListParamsOrderBy = "date_created"
@@ -5495,6 +5569,20 @@ type CreditGuardStoredFields struct {
 	UserData9 string `json:"user_data_9"`
 }
 
+// Currency defines model for Currency.
+type Currency struct {
+	// CurrencyCode The currency code under ISO 4217
+	CurrencyCode string `json:"currency_code"`
+
+	// CurrencySymbol The currency symbol
+	CurrencySymbol string `json:"currency_symbol"`
+}
+
+// CurrencyArrayResult defines model for CurrencyArrayResult.
+type CurrencyArrayResult struct {
+	Currencies []Currency `json:"currencies"`
+}
+
 // DeliveryZone defines model for DeliveryZone.
 type DeliveryZone struct {
 	Countries []Country `json:"countries"`
@@ -7211,6 +7299,22 @@ type GetPublisherTermGetError struct {
 // - 1001: Term not found
 type GetPublisherTermGetErrorCode int
 
+// GetPublisherTermListError defines model for GetPublisherTermListError.
+type GetPublisherTermListError struct {
+	// Code - 2: Access denied
+	//
+	// - 1001: Term not found
+	//
+	Code             GetPublisherTermListErrorCode `json:"code"`
+	LocalizedMessage *string                       `json:"localizedMessage,omitempty"`
+	Message          *string                       `json:"message,omitempty"`
+}
+
+// GetPublisherTermListErrorCode - 2: Access denied
+//
+// - 1001: Term not found
+type GetPublisherTermListErrorCode int
+
 // GetPublisherTermStatsListError defines model for GetPublisherTermStatsListError.
 type GetPublisherTermStatsListError struct {
 	// Code - 2: Access denied
@@ -15592,6 +15696,9 @@ type Term struct {
 	// Description The description of the term
 	Description string `json:"description"`
 
+	// Disabled Whether the term is disabled
+	Disabled *bool `json:"disabled,omitempty"`
+
 	// EvtCdsProductId The <a href="https://docs.piano.io/external-service-term/#externalcds">CDS</a> product ID.
 	EvtCdsProductId *string `json:"evt_cds_product_id,omitempty"`
 
@@ -15908,7 +16015,7 @@ type TermConversionDTOType string
 
 // TermConversionDTOArrayResult defines model for TermConversionDTOArrayResult.
 type TermConversionDTOArrayResult struct {
-	TermConversionDTO []TermConversionDTO `json:"TermConversionDTO"`
+	Conversions []TermConversionDTO `json:"conversions"`
 }
 
 // TermConversionData defines model for TermConversionData.
@@ -19267,6 +19374,66 @@ type GetPublisherTermGetParams struct {
 	TermId string `form:"term_id" json:"term_id"`
 }
 
+// GetPublisherTermListParams defines parameters for GetPublisherTermList.
+type GetPublisherTermListParams struct {
+	// Aid The application ID
+	Aid string `form:"aid" json:"aid"`
+
+	// Rid The resource ID
+	Rid *string `form:"rid,omitempty" json:"rid,omitempty"`
+
+	// IncludeType Type of terms to include into the list
+	IncludeType *[]GetPublisherTermListParamsIncludeType `form:"include_type,omitempty" json:"include_type,omitempty"`
+
+	// ExcludeType Type of terms to exclude from the list
+	ExcludeType *[]GetPublisherTermListParamsExcludeType `form:"exclude_type,omitempty" json:"exclude_type,omitempty"`
+
+	// TermId Term id to list
+	TermId *string `form:"term_id,omitempty" json:"term_id,omitempty"`
+
+	// ResourceType Type of resource
+	ResourceType *GetPublisherTermListParamsResourceType `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// Source Type of external API source
+	Source *[]GetPublisherTermListParamsSource `form:"source,omitempty" json:"source,omitempty"`
+
+	// Type Type of term to list
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// OrderBy Field to order by: term_name, resource_type, resource_name
+	OrderBy *GetPublisherTermListParamsOrderBy `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// OrderDirection Order direction (asc/desc)
+	OrderDirection *GetPublisherTermListParamsOrderDirection `form:"order_direction,omitempty" json:"order_direction,omitempty"`
+
+	// Offset Offset from which to start returning results
+	Offset int32 `form:"offset" json:"offset"`
+
+	// Limit Maximum index of returned results
+	Limit int32 `form:"limit" json:"limit"`
+
+	// Q Search value
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+}
+
+// GetPublisherTermListParamsIncludeType defines parameters for GetPublisherTermList.
+type GetPublisherTermListParamsIncludeType string
+
+// GetPublisherTermListParamsExcludeType defines parameters for GetPublisherTermList.
+type GetPublisherTermListParamsExcludeType string
+
+// GetPublisherTermListParamsResourceType defines parameters for GetPublisherTermList.
+type GetPublisherTermListParamsResourceType string
+
+// GetPublisherTermListParamsSource defines parameters for GetPublisherTermList.
+type GetPublisherTermListParamsSource string
+
+// GetPublisherTermListParamsOrderBy defines parameters for GetPublisherTermList.
+type GetPublisherTermListParamsOrderBy string
+
+// GetPublisherTermListParamsOrderDirection defines parameters for GetPublisherTermList.
+type GetPublisherTermListParamsOrderDirection string
+
 // GetPublisherTermStatsListParams defines parameters for GetPublisherTermStatsList.
 type GetPublisherTermStatsListParams struct {
 	// Aid The application ID
@@ -21123,6 +21290,9 @@ type ClientInterface interface {
 
 	PostPublisherTermGiftUpdateWithFormdataBody(ctx context.Context, body PostPublisherTermGiftUpdateFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPublisherTermList request
+	GetPublisherTermList(ctx context.Context, params *GetPublisherTermListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPublisherTermPaymentCreateWithBody request with any body
 	PostPublisherTermPaymentCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -25792,6 +25962,18 @@ func (c *Client) PostPublisherTermGiftUpdateWithFormdataBody(ctx context.Context
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetPublisherTermList(ctx context.Context, params *GetPublisherTermListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublisherTermListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostPublisherTermPaymentCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostPublisherTermPaymentCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -41294,6 +41476,235 @@ func NewPostPublisherTermGiftUpdateRequestWithBody(server string, contentType st
 	return req, nil
 }
 
+// NewGetPublisherTermListRequest generates requests for GetPublisherTermList
+func NewGetPublisherTermListRequest(server string, params *GetPublisherTermListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/publisher/term/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aid", runtime.ParamLocationQuery, params.Aid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Rid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rid", runtime.ParamLocationQuery, *params.Rid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "include_type", runtime.ParamLocationQuery, *params.IncludeType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude_type", runtime.ParamLocationQuery, *params.ExcludeType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TermId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "term_id", runtime.ParamLocationQuery, *params.TermId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ResourceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource_type", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_by", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_direction", runtime.ParamLocationQuery, *params.OrderDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewPostPublisherTermPaymentCreateRequestWithFormdataBody calls the generic PostPublisherTermPaymentCreate builder with application/x-www-form-urlencoded body
 func NewPostPublisherTermPaymentCreateRequestWithFormdataBody(server string, body PostPublisherTermPaymentCreateFormdataRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -45263,6 +45674,9 @@ type ClientWithResponsesInterface interface {
 
 	PostPublisherTermGiftUpdateWithFormdataBodyWithResponse(ctx context.Context, body PostPublisherTermGiftUpdateFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostPublisherTermGiftUpdateResponse, error)
 
+	// GetPublisherTermListWithResponse request
+	GetPublisherTermListWithResponse(ctx context.Context, params *GetPublisherTermListParams, reqEditors ...RequestEditorFn) (*GetPublisherTermListResponse, error)
+
 	// PostPublisherTermPaymentCreateWithBodyWithResponse request with any body
 	PostPublisherTermPaymentCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPublisherTermPaymentCreateResponse, error)
 
@@ -51045,6 +51459,30 @@ func (r PostPublisherTermGiftUpdateResponse) StatusCode() int {
 	return 0
 }
 
+type GetPublisherTermListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublisherTermListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublisherTermListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostPublisherTermPaymentCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -55535,6 +55973,15 @@ func (c *ClientWithResponses) PostPublisherTermGiftUpdateWithFormdataBodyWithRes
 	return ParsePostPublisherTermGiftUpdateResponse(rsp)
 }
 
+// GetPublisherTermListWithResponse request returning *GetPublisherTermListResponse
+func (c *ClientWithResponses) GetPublisherTermListWithResponse(ctx context.Context, params *GetPublisherTermListParams, reqEditors ...RequestEditorFn) (*GetPublisherTermListResponse, error) {
+	rsp, err := c.GetPublisherTermList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublisherTermListResponse(rsp)
+}
+
 // PostPublisherTermPaymentCreateWithBodyWithResponse request with arbitrary body returning *PostPublisherTermPaymentCreateResponse
 func (c *ClientWithResponses) PostPublisherTermPaymentCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPublisherTermPaymentCreateResponse, error) {
 	rsp, err := c.PostPublisherTermPaymentCreateWithBody(ctx, contentType, body, reqEditors...)
@@ -62381,6 +62828,34 @@ func ParsePostPublisherTermGiftUpdateResponse(rsp *http.Response) (*PostPublishe
 	return response, nil
 }
 
+// ParseGetPublisherTermListResponse parses an HTTP response from a GetPublisherTermListWithResponse call
+func ParseGetPublisherTermListResponse(rsp *http.Response) (*GetPublisherTermListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPublisherTermListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			union json.RawMessage
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostPublisherTermPaymentCreateResponse parses an HTTP response from a PostPublisherTermPaymentCreateWithResponse call
 func ParsePostPublisherTermPaymentCreateResponse(rsp *http.Response) (*PostPublisherTermPaymentCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
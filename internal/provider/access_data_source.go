@@ -0,0 +1,238 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_id"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &AccessDataSource{}
+	_ datasource.DataSourceWithConfigure        = &AccessDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &AccessDataSource{}
+)
+
+func NewAccessDataSource() datasource.DataSource {
+	return &AccessDataSource{}
+}
+
+// AccessDataSource defines the data source implementation.
+type AccessDataSource struct {
+	client   piano_publisher.ClientInterface
+	idClient *piano_id.Client
+}
+
+// AccessDataSourceModel describes the data source data model.
+type AccessDataSourceModel struct {
+	Aid        types.String `tfsdk:"aid"`         // The application ID
+	Uid        types.String `tfsdk:"uid"`         // The user ID
+	Email      types.String `tfsdk:"email"`       // The user email
+	Rid        types.String `tfsdk:"rid"`         // The resource ID
+	Granted    types.Bool   `tfsdk:"granted"`     // Whether the user has access
+	AccessId   types.String `tfsdk:"access_id"`   // The access ID
+	StartDate  types.Int64  `tfsdk:"start_date"`  // The start date
+	ExpireDate types.Int64  `tfsdk:"expire_date"` // The expire date of the access; null means unlimited
+}
+
+func (*AccessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access"
+}
+
+func (*AccessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Access data source. This data source is used to check whether a user has access to a resource, " +
+			"for example to verify that a grant access term actually granted access. " +
+			"`granted` is false when the user has no access.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				MarkdownDescription: "The application ID",
+				Required:            true,
+				Validators:          aidValidators(),
+			},
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "The user ID. Either `uid` or `email` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The user email. Either `uid` or `email` must be set.",
+				Optional:            true,
+			},
+			"rid": schema.StringAttribute{
+				MarkdownDescription: "The resource ID. When this value is null, the access to any resource is checked " +
+					"and the access that expires last is reported.",
+				Optional: true,
+				Computed: true,
+			},
+			"granted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has access",
+				Computed:            true,
+			},
+			"access_id": schema.StringAttribute{
+				MarkdownDescription: "The access ID",
+				Computed:            true,
+			},
+			"start_date": schema.Int64Attribute{
+				MarkdownDescription: "The start date",
+				Computed:            true,
+			},
+			"expire_date": schema.Int64Attribute{
+				MarkdownDescription: "The expire date of the access; null means unlimited",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (*AccessDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uid"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+func (d *AccessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.publisherClient
+	d.idClient = &client.idClient
+}
+
+func (d *AccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AccessDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Uid.IsNull() {
+		user := fetchPublisherUser(ctx, d.idClient, state.Aid.ValueString(), state.Uid, state.Email, &resp.Diagnostics)
+		if user == nil {
+			return
+		}
+		state.Uid = types.StringValue(user.Uid)
+	}
+
+	var access *piano_publisher.AccessDTO
+	if !state.Rid.IsNull() {
+		access = d.checkAccess(ctx, state, resp)
+	} else {
+		access = d.lastAccess(ctx, state, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Granted = types.BoolValue(false)
+	state.AccessId = types.StringNull()
+	state.StartDate = types.Int64Null()
+	state.ExpireDate = types.Int64Null()
+	if access != nil && access.Granted {
+		state.Granted = types.BoolValue(true)
+		state.AccessId = types.StringValue(access.AccessId)
+		if state.Rid.IsNull() {
+			state.Rid = types.StringValue(access.Resource.Rid)
+		}
+		state.StartDate = types.Int64Value(int64(access.StartDate))
+		if access.ExpireDate != 0 {
+			state.ExpireDate = types.Int64Value(int64(access.ExpireDate))
+		}
+	}
+	tflog.Trace(ctx, "read an access data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// checkAccess returns the access of the user to the resource.
+func (d *AccessDataSource) checkAccess(ctx context.Context, state AccessDataSourceModel, resp *datasource.ReadResponse) *piano_publisher.AccessDTO {
+	response, err := d.client.GetPublisherUserAccessCheck(ctx, &piano_publisher.GetPublisherUserAccessCheckParams{
+		Aid: state.Aid.ValueString(),
+		Uid: state.Uid.ValueString(),
+		Rid: state.Rid.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check access, got error: %s", err))
+		return nil
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return nil
+	}
+
+	result := piano_publisher.AccessDTOResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return nil
+	}
+	return &result.AccessDTO
+}
+
+// lastAccess returns the granted access of the user that expires last, or nil if the user has no access.
+func (d *AccessDataSource) lastAccess(ctx context.Context, state AccessDataSourceModel, resp *datasource.ReadResponse) *piano_publisher.AccessDTO {
+	accesses, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.AccessDTO, int, error) {
+		params := piano_publisher.GetPublisherUserAccessListParams{
+			Aid:    state.Aid.ValueString(),
+			Uid:    state.Uid.ValueString(),
+			Offset: int32(offset),
+			Limit:  int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching accesses of %s in %s (offset: %d, limit: %d)", params.Uid, params.Aid, params.Offset, params.Limit))
+		response, err := d.client.GetPublisherUserAccessList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch accesses, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.AccessDTOArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.AccessDTO, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return nil
+	}
+	return lastGrantedAccess(accesses)
+}
+
+// lastGrantedAccess returns the granted access that expires last. An access without expire date never expires.
+func lastGrantedAccess(accesses []piano_publisher.AccessDTO) *piano_publisher.AccessDTO {
+	var ret *piano_publisher.AccessDTO
+	for i, access := range accesses {
+		if !access.Granted {
+			continue
+		}
+		if ret == nil || ret.ExpireDate != 0 && (access.ExpireDate == 0 || access.ExpireDate > ret.ExpireDate) {
+			ret = &accesses[i]
+		}
+	}
+	return ret
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_id"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAccessDataSourceRead(t *testing.T) {
+	cases := []struct {
+		name               string
+		config             AccessDataSourceModel
+		expectedPath       string
+		response           string
+		expectedGranted    bool
+		expectedRid        types.String
+		expectedExpireDate types.Int64
+	}{
+		{
+			name:               "granted access to the resource",
+			config:             AccessDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringValue("UXXXXXX"), Rid: types.StringValue("RXXXXXX")},
+			expectedPath:       "/publisher/user/access/check",
+			response:           `{"code":0,"AccessDTO":{"access_id":"AXXXXXX","granted":true,"start_date":1735657200,"expire_date":1767193200,"resource":{"rid":"RXXXXXX"}}}`,
+			expectedGranted:    true,
+			expectedRid:        types.StringValue("RXXXXXX"),
+			expectedExpireDate: types.Int64Value(1767193200),
+		},
+		{
+			name:               "no access to the resource",
+			config:             AccessDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringValue("UXXXXXX"), Rid: types.StringValue("RXXXXXX")},
+			expectedPath:       "/publisher/user/access/check",
+			response:           `{"code":0,"AccessDTO":{"granted":false}}`,
+			expectedRid:        types.StringValue("RXXXXXX"),
+			expectedExpireDate: types.Int64Null(),
+		},
+		{
+			name:         "access to any resource",
+			config:       AccessDataSourceModel{Aid: types.StringValue("example"), Email: types.StringValue("user@example.com")},
+			expectedPath: "/publisher/user/access/list",
+			response: `{"code":0,"total":3,"AccessDTO":[` +
+				`{"access_id":"A1","granted":true,"start_date":1735657200,"expire_date":1767193200,"resource":{"rid":"R1"}},` +
+				`{"access_id":"A2","granted":true,"start_date":1735657200,"expire_date":0,"resource":{"rid":"R2"}},` +
+				`{"access_id":"A3","granted":false,"start_date":1735657200,"expire_date":0,"resource":{"rid":"R3"}}]}`,
+			expectedGranted:    true,
+			expectedRid:        types.StringValue("R2"),
+			expectedExpireDate: types.Int64Null(),
+		},
+		{
+			name:               "no access to any resource",
+			config:             AccessDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringValue("UXXXXXX")},
+			expectedPath:       "/publisher/user/access/list",
+			response:           `{"code":0,"total":0,"AccessDTO":[]}`,
+			expectedRid:        types.StringNull(),
+			expectedExpireDate: types.Int64Null(),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if req.URL.Path == "/publisher/users/get" {
+					if email := req.URL.Query().Get("email"); email != "user@example.com" {
+						t.Errorf("unexpected email: %s", email)
+					}
+					fmt.Fprint(w, `{"uid":"UXXXXXX","email":"user@example.com","custom_fields":[]}`)
+					return
+				}
+				if req.URL.Path != c.expectedPath {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				if uid := req.URL.Query().Get("uid"); uid != "UXXXXXX" {
+					t.Errorf("expected uid=UXXXXXX, got %s", uid)
+				}
+				fmt.Fprint(w, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			idClient, err := piano_id.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &AccessDataSource{client: client, idClient: idClient}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &c.config)
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual AccessDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if actual.Uid.ValueString() != "UXXXXXX" {
+				t.Errorf("expected uid to be resolved, got %s", actual.Uid)
+			}
+			if actual.Granted.ValueBool() != c.expectedGranted {
+				t.Errorf("expected granted to be %t, got %s", c.expectedGranted, actual.Granted)
+			}
+			if !actual.Rid.Equal(c.expectedRid) {
+				t.Errorf("expected rid to be %s, got %s", c.expectedRid, actual.Rid)
+			}
+			if !actual.ExpireDate.Equal(c.expectedExpireDate) {
+				t.Errorf("expected expire_date to be %s, got %s", c.expectedExpireDate, actual.ExpireDate)
+			}
+			if !c.expectedGranted && !actual.AccessId.IsNull() {
+				t.Errorf("expected access_id to be null, got %s", actual.AccessId)
+			}
+		})
+	}
+}
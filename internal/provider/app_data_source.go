@@ -28,7 +28,7 @@ func NewAppDataSource() datasource.DataSource {
 
 // LicenseeDataSource defines the data source implementation.
 type AppDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 // AppDataSourceModel describes the data source data model.
@@ -45,6 +45,24 @@ type AppDataSourceModel struct {
 	Logo1        types.String `tfsdk:"logo1"`         // Primary image displayed within the dashboard
 	Logo2        types.String `tfsdk:"logo2"`         // Secondary image displayed within the ticket
 	State        types.String `tfsdk:"state"`         // Current state of the app
+	// SupportedCurrencies is the list of currencies available in the app
+	SupportedCurrencies []AppCurrencyDataSourceModel `tfsdk:"supported_currencies"`
+}
+
+type AppCurrencyDataSourceModel struct {
+	CurrencyCode   types.String `tfsdk:"currency_code"`   // The currency code under ISO 4217
+	CurrencySymbol types.String `tfsdk:"currency_symbol"` // The currency symbol
+}
+
+func AppCurrencyDataSourceModelsFrom(data []piano_publisher.Currency) []AppCurrencyDataSourceModel {
+	ret := []AppCurrencyDataSourceModel{}
+	for _, currency := range data {
+		ret = append(ret, AppCurrencyDataSourceModel{
+			CurrencyCode:   types.StringValue(currency.CurrencyCode),
+			CurrencySymbol: types.StringValue(currency.CurrencySymbol),
+		})
+	}
+	return ret
 }
 
 func (*AppDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -58,6 +76,7 @@ func (*AppDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 			"aid": schema.StringAttribute{
 				MarkdownDescription: "piano application id",
 				Required:            true,
+				Validators:          aidValidators(),
 			},
 			"default_lang": schema.StringAttribute{
 				MarkdownDescription: "default language",
@@ -99,24 +118,30 @@ func (*AppDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 				MarkdownDescription: "current state of the app",
 				Computed:            true,
 			},
+			"supported_currencies": schema.ListNestedAttribute{
+				MarkdownDescription: "currencies available in the app, which depend on the payment provider of the app",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"currency_code": schema.StringAttribute{
+							MarkdownDescription: "currency code under ISO 4217",
+							Computed:            true,
+						},
+						"currency_symbol": schema.StringAttribute{
+							MarkdownDescription: "currency symbol",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *AppDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *piano.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
@@ -166,6 +191,25 @@ func (d *AppDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	state.Logo2 = types.StringPointerValue(result.App.Logo2)
 	state.State = types.StringValue(string(result.App.State))
 	state.UserProvider = types.StringValue(string(result.App.UserProvider))
+
+	response, err = d.client.GetPublisherAppCurrencies(ctx, &piano_publisher.GetPublisherAppCurrenciesParams{
+		Aid: state.Aid.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch app currencies, got error: %s", err))
+		return
+	}
+	anyResponse, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	currencies := piano_publisher.CurrencyArrayResult{}
+	err = json.Unmarshal(anyResponse.Raw, &currencies)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	state.SupportedCurrencies = AppCurrencyDataSourceModelsFrom(currencies.Currencies)
 	tflog.Trace(ctx, "read an app data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -0,0 +1,28 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+)
+
+func TestAppCurrencyDataSourceModelsFrom_MultipleCurrencies(t *testing.T) {
+	raw := `{"code":0,"currencies":[{"currency_code":"USD","currency_symbol":"$"},{"currency_code":"EUR","currency_symbol":"€"},{"currency_code":"JPY","currency_symbol":"¥"}]}`
+	result := piano_publisher.CurrencyArrayResult{}
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		t.Fatal(err)
+	}
+	actual := AppCurrencyDataSourceModelsFrom(result.Currencies)
+	expected := [][2]string{{"USD", "$"}, {"EUR", "€"}, {"JPY", "¥"}}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d currencies, got %d", len(expected), len(actual))
+	}
+	for i, currency := range actual {
+		if currency.CurrencyCode.ValueString() != expected[i][0] || currency.CurrencySymbol.ValueString() != expected[i][1] {
+			t.Errorf("expected %v at %d, got %s %s", expected[i], i, currency.CurrencyCode, currency.CurrencySymbol)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+)
+
+// zeroDecimalCurrencies are the currencies without minor units, e.g. 1200 JPY is 1200 in the minor unit.
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true,
+	"KRW": true,
+}
+
+// completeBillingPlanTablePrices fills the numeric prices of a billing plan table row that piano.io API omits.
+//
+// piano.io API returns the prices of some rows only as formatted strings such as "$19.99" or "1.234,50 €".
+// The missing price_value is parsed from price, and the missing price_and_tax from price_charged_str,
+// falling back to price_value. The missing price_and_tax_in_minor_unit is computed from price_and_tax when the currency is known.
+// A numeric value returned from piano.io API is always kept as is.
+func completeBillingPlanTablePrices(data piano_publisher.PaymentBillingPlanTable) piano_publisher.PaymentBillingPlanTable {
+	if data.PriceValue == nil && data.Price != nil {
+		if price, ok := parseFormattedPrice(*data.Price); ok {
+			data.PriceValue = &price
+		}
+	}
+	if data.PriceAndTax == nil && data.PriceChargedStr != nil {
+		if price, ok := parseFormattedPrice(*data.PriceChargedStr); ok {
+			data.PriceAndTax = &price
+		}
+	}
+	if data.PriceAndTax == nil && data.PriceValue != nil {
+		price := *data.PriceValue
+		data.PriceAndTax = &price
+	}
+	if data.PriceAndTaxInMinorUnit == nil && data.PriceAndTax != nil && data.Currency != nil && *data.Currency != "" {
+		scale := 100.0
+		if zeroDecimalCurrencies[*data.Currency] {
+			scale = 1
+		}
+		price := float32(math.Round(*data.PriceAndTax * scale))
+		data.PriceAndTaxInMinorUnit = &price
+	}
+	return data
+}
+
+// parseFormattedPrice parses a price formatted with a currency symbol or code, e.g. "$19.99", "¥1,200" or "19,99 €".
+//
+// When both '.' and ',' appear, the last one is the decimal separator.
+// A lone ',' is the decimal separator only when it is followed by one or two digits, as in "19,99 €".
+// It reports false when the string has no digits, e.g. "Free".
+func parseFormattedPrice(formatted string) (float64, bool) {
+	number := strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '.' || r == ',' || r == '-' {
+			return r
+		}
+		return -1
+	}, formatted)
+	number = strings.Trim(number, ".,")
+	if strings.IndexFunc(number, func(r rune) bool { return r >= '0' && r <= '9' }) < 0 {
+		return 0, false
+	}
+	dot := strings.LastIndex(number, ".")
+	comma := strings.LastIndex(number, ",")
+	switch {
+	case dot >= 0 && comma >= 0 && comma > dot:
+		number = strings.ReplaceAll(number, ".", "")
+		number = strings.Replace(number, ",", ".", 1)
+	case dot >= 0 && comma >= 0:
+		number = strings.ReplaceAll(number, ",", "")
+	case comma >= 0 && strings.Count(number, ",") == 1 && len(number)-comma-1 <= 2:
+		number = strings.Replace(number, ",", ".", 1)
+	default:
+		number = strings.ReplaceAll(number, ",", "")
+	}
+	price, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, false
+	}
+	return price, true
+}
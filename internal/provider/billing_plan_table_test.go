@@ -0,0 +1,95 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+)
+
+func TestParseFormattedPrice(t *testing.T) {
+	cases := []struct {
+		formatted string
+		expected  float64
+		ok        bool
+	}{
+		{formatted: "$19.99", expected: 19.99, ok: true},
+		{formatted: "¥1,200", expected: 1200, ok: true},
+		{formatted: "19,99 €", expected: 19.99, ok: true},
+		{formatted: "1.234,50 €", expected: 1234.5, ok: true},
+		{formatted: "US$1,234.50", expected: 1234.5, ok: true},
+		{formatted: "9.99 USD", expected: 9.99, ok: true},
+		{formatted: "Free", ok: false},
+		{formatted: "", ok: false},
+	}
+	for _, c := range cases {
+		t.Run(c.formatted, func(t *testing.T) {
+			actual, ok := parseFormattedPrice(c.formatted)
+			if ok != c.ok || actual != c.expected {
+				t.Errorf("expected %v %t, got %v %t", c.expected, c.ok, actual, ok)
+			}
+		})
+	}
+}
+
+func TestCompleteBillingPlanTablePrices(t *testing.T) {
+	cases := []struct {
+		name                   string
+		row                    string
+		priceValue             float64
+		priceAndTax            float64
+		priceAndTaxInMinorUnit float32
+	}{
+		{
+			name:                   "numeric prices",
+			row:                    `{"currency":"USD","price":"$19.99","priceValue":19.99,"priceAndTax":21.99,"priceAndTaxInMinorUnit":2199,"priceChargedStr":"$21.99"}`,
+			priceValue:             19.99,
+			priceAndTax:            21.99,
+			priceAndTaxInMinorUnit: 2199,
+		},
+		{
+			name:                   "string prices only",
+			row:                    `{"currency":"EUR","price":"9,99 €","priceChargedStr":"11,89 €","isFree":"false"}`,
+			priceValue:             9.99,
+			priceAndTax:            11.89,
+			priceAndTaxInMinorUnit: 1189,
+		},
+		{
+			name:                   "price value without tax",
+			row:                    `{"currency":"JPY","price":"¥1,200","priceValue":1200}`,
+			priceValue:             1200,
+			priceAndTax:            1200,
+			priceAndTaxInMinorUnit: 1200,
+		},
+		{
+			name:                   "string price with numeric tax",
+			row:                    `{"currency":"GBP","price":"£4.50","priceAndTax":5.4}`,
+			priceValue:             4.5,
+			priceAndTax:            5.4,
+			priceAndTaxInMinorUnit: 540,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var row piano_publisher.PaymentBillingPlanTable
+			if err := json.Unmarshal([]byte(c.row), &row); err != nil {
+				t.Fatal(err)
+			}
+			actual := PaymentBillingPlanTableResourceModelFrom(row)
+			if actual.PriceValue.ValueFloat64() != c.priceValue || actual.PriceAndTax.ValueFloat64() != c.priceAndTax || actual.PriceAndTaxInMinorUnit.ValueFloat32() != c.priceAndTaxInMinorUnit {
+				t.Errorf("expected %v %v %v, got %s %s %s", c.priceValue, c.priceAndTax, c.priceAndTaxInMinorUnit, actual.PriceValue, actual.PriceAndTax, actual.PriceAndTaxInMinorUnit)
+			}
+		})
+	}
+
+	var free piano_publisher.PaymentBillingPlanTable
+	if err := json.Unmarshal([]byte(`{"price":"Free","isFree":"true"}`), &free); err != nil {
+		t.Fatal(err)
+	}
+	actual := PaymentBillingPlanTableDataSourceModelFrom(free)
+	if !actual.PriceValue.IsNull() || !actual.PriceAndTaxInMinorUnit.IsNull() {
+		t.Errorf("expected prices of an unparsable row to be null, got %s %s", actual.PriceValue, actual.PriceAndTaxInMinorUnit)
+	}
+}
@@ -28,7 +28,7 @@ func NewContractDataSource() datasource.DataSource {
 
 // ContractDataSource defines the data source implementation.
 type ContractDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 // SchedulePeriodModel describes the schedule period data model.
@@ -73,6 +73,7 @@ func (d *ContractDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			"aid": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
 			},
 			"contract_id": schema.StringAttribute{
 				Required:            true,
@@ -159,18 +160,9 @@ func (d *ContractDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 }
 
 func (d *ContractDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
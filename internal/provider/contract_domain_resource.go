@@ -33,6 +33,7 @@ type ContractDomainResourceModel struct {
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &ContractDomainResource{}
+	_ resource.ResourceWithModifyPlan  = &ContractDomainResource{}
 	_ resource.ResourceWithImportState = &ContractDomainResource{}
 )
 
@@ -42,7 +43,8 @@ func NewContractDomainResource() resource.Resource {
 
 // ContractDomainResource defines the resource implementation.
 type ContractDomainResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
 }
 
 func (*ContractDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,13 +55,7 @@ func (*ContractDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "ContractDomain Resource. This resource is used to create, update, and delete a contract domain.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
+			"aid": defaultAidAttribute(),
 			"contract_domain_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The id of the contract domain",
@@ -83,22 +79,18 @@ func (*ContractDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 }
 
 func (r *ContractDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *ContractDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (r *ContractDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -207,7 +199,7 @@ func (r *ContractDomainResource) Delete(ctx context.Context, req resource.Delete
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contract, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,324 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ContractIpRangeResourceModel describes the resource data model.
+type ContractIpRangeResourceModel struct {
+	Aid               types.String `tfsdk:"aid"`                  // The application ID
+	ContractId        types.String `tfsdk:"contract_id"`          // The public ID of the contract
+	ContractIpRangeId types.String `tfsdk:"contract_ip_range_id"` // The public ID of the contract ip range
+	IpRange           types.String `tfsdk:"ip_range"`             // The IP address range
+	Status            types.String `tfsdk:"status"`               // The status of the contract ip range
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ContractIpRangeResource{}
+	_ resource.ResourceWithModifyPlan  = &ContractIpRangeResource{}
+	_ resource.ResourceWithImportState = &ContractIpRangeResource{}
+)
+
+func NewContractIpRangeResource() resource.Resource {
+	return &ContractIpRangeResource{}
+}
+
+// ContractIpRangeResource defines the resource implementation.
+type ContractIpRangeResource struct {
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+}
+
+func (*ContractIpRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contract_ip_range"
+}
+
+func (*ContractIpRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "ContractIpRange Resource. This resource is used to grant access to users in an IP address range through an `IP_RANGE_CONTRACT` contract. " +
+			"Use `piano_contract_domain` for `EMAIL_DOMAIN_CONTRACT` contracts.",
+		Attributes: map[string]schema.Attribute{
+			"aid": defaultAidAttribute(),
+			"contract_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The public ID of the contract. The contract type must be `IP_RANGE_CONTRACT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contract_ip_range_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public ID of the contract ip range",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_range": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The IP address range. Either a single IPv4/IPv6 address (`192.0.2.1`), " +
+					"a CIDR block (`192.0.2.0/24`) or an inclusive address range (`192.0.2.1-192.0.2.255`).",
+				Validators: []validator.String{
+					ipRangeValidator{},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the contract ip range",
+			},
+		},
+	}
+}
+
+func (r *ContractIpRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *ContractIpRangeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+}
+
+func (r *ContractIpRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContractIpRangeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating contract ip range %s in %s", plan.IpRange.ValueString(), plan.ContractId.ValueString()))
+
+	response, err := r.client.PostPublisherLicensingContractIpRangeCreateWithFormdataBody(ctx, piano_publisher.PostPublisherLicensingContractIpRangeCreateFormdataRequestBody{
+		Aid:        plan.Aid.ValueString(),
+		ContractId: plan.ContractId.ValueString(),
+		IpRange:    plan.IpRange.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create contract ip range, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.ContractIpRangeResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	plan.ContractIpRangeId = types.StringValue(result.ContractIpRange.ContractIpRangeId)
+	plan.Status = types.StringValue(string(result.ContractIpRange.Status))
+	tflog.Info(ctx, fmt.Sprintf("complete creating contract ip range %s(id: %s)", plan.IpRange, plan.ContractIpRangeId))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContractIpRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContractIpRangeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ipRanges, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.ContractIpRange, int, error) {
+		params := piano_publisher.GetPublisherLicensingContractIpRangeListParams{
+			Aid:        state.Aid.ValueString(),
+			ContractId: state.ContractId.ValueString(),
+			Offset:     int32(offset),
+			Limit:      int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching ip ranges of contract %s (offset: %d, limit: %d)", params.ContractId, params.Offset, params.Limit))
+		response, err := r.client.GetPublisherLicensingContractIpRangeList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch contract ip ranges, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.ContractIpRangeArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.ContractIpRange, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	for _, item := range ipRanges {
+		if item.ContractIpRangeId != state.ContractIpRangeId.ValueString() {
+			continue
+		}
+		state.IpRange = types.StringValue(item.IpRange)
+		state.Status = types.StringValue(string(item.Status))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("contract ip range %s is not found in contract %s. removing it from state", state.ContractIpRangeId.ValueString(), state.ContractId.ValueString()))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ContractIpRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContractIpRangeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.PostPublisherLicensingContractIpRangeUpdateWithFormdataBody(ctx, piano_publisher.PostPublisherLicensingContractIpRangeUpdateFormdataRequestBody{
+		Aid:               plan.Aid.ValueString(),
+		ContractId:        plan.ContractId.ValueString(),
+		ContractIpRangeId: plan.ContractIpRangeId.ValueString(),
+		IpRange:           plan.IpRange.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update contract ip range, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.ContractIpRangeResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	plan.Status = types.StringValue(string(result.ContractIpRange.Status))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContractIpRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContractIpRangeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.PostPublisherLicensingContractIpRangeRemoveWithFormdataBody(ctx, piano_publisher.PostPublisherLicensingContractIpRangeRemoveFormdataRequestBody{
+		Aid:               state.Aid.ValueString(),
+		ContractId:        state.ContractId.ValueString(),
+		ContractIpRangeId: state.ContractIpRangeId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contract ip range, got error: %s", err))
+		return
+	}
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+}
+
+func (r *ContractIpRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceId, err := ContractIpRangeResourceIdFromString(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ContractIpRange resource id", fmt.Sprintf("Unable to parse contract ip range resource id, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aid"), resourceId.Aid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("contract_id"), resourceId.ContractId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("contract_ip_range_id"), resourceId.ContractIpRangeId)...)
+}
+
+// ContractIpRangeResourceId represents a piano.io contract ip range identifier in "{aid}/{contract_id}/{contract_ip_range_id}" format.
+type ContractIpRangeResourceId struct {
+	Aid               string
+	ContractId        string
+	ContractIpRangeId string
+}
+
+func ContractIpRangeResourceIdFromString(input string) (*ContractIpRangeResourceId, error) {
+	parts := strings.Split(input, "/")
+	if len(parts) != 3 {
+		return nil, errors.New("contract ip range id must be in {aid}/{contract_id}/{contract_ip_range_id} format")
+	}
+	return &ContractIpRangeResourceId{Aid: parts[0], ContractId: parts[1], ContractIpRangeId: parts[2]}, nil
+}
+
+// ipRangeValidator validates that a string is a single IP address, a CIDR block or an inclusive range of IP addresses.
+type ipRangeValidator struct{}
+
+func (ipRangeValidator) Description(ctx context.Context) string {
+	return "value must be an IP address, a CIDR block or a range of IP addresses such as 192.0.2.1-192.0.2.255"
+}
+
+func (v ipRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipRangeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validateIpRange(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Range",
+			fmt.Sprintf("%s, got %q: %s", v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// validateIpRange reports an error unless input is a single IP address, a CIDR block
+// or a range of IP addresses of the same family in ascending order.
+func validateIpRange(input string) error {
+	if strings.Contains(input, "/") {
+		_, err := netip.ParsePrefix(input)
+		return err
+	}
+	start, end, isRange := strings.Cut(input, "-")
+	from, err := netip.ParseAddr(strings.TrimSpace(start))
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	to, err := netip.ParseAddr(strings.TrimSpace(end))
+	if err != nil {
+		return err
+	}
+	if from.Is4() != to.Is4() {
+		return errors.New("start and end of the range must be the same IP version")
+	}
+	if to.Less(from) {
+		return errors.New("start of the range must not be greater than end")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateIpRange(t *testing.T) {
+	cases := []struct {
+		input string
+		valid bool
+	}{
+		{input: "192.0.2.1", valid: true},
+		{input: "2001:db8::1", valid: true},
+		{input: "192.0.2.0/24", valid: true},
+		{input: "2001:db8::/32", valid: true},
+		{input: "192.0.2.1-192.0.2.255", valid: true},
+		{input: "192.0.2.1 - 192.0.2.255", valid: true},
+		{input: "192.0.2.0/33"},
+		{input: "192.0.2/24"},
+		{input: "192.0.2.256"},
+		{input: "192.0.2.255-192.0.2.1"},
+		{input: "192.0.2.1-2001:db8::1"},
+		{input: "example.com"},
+		{input: ""},
+	}
+	for _, c := range cases {
+		if err := validateIpRange(c.input); (err == nil) != c.valid {
+			t.Errorf("validateIpRange(%q): expected valid %t, got error %v", c.input, c.valid, err)
+		}
+	}
+}
+
+func TestContractIpRangeResourceRead(t *testing.T) {
+	otherIpRanges := func(count int) []piano_publisher.ContractIpRange {
+		ipRanges := make([]piano_publisher.ContractIpRange, count)
+		for i := range ipRanges {
+			ipRanges[i] = piano_publisher.ContractIpRange{ContractIpRangeId: fmt.Sprintf("other%d", i), IpRange: fmt.Sprintf("198.51.100.%d", i), Status: piano_publisher.ContractIpRangeStatusVALID}
+		}
+		return ipRanges
+	}
+	target := piano_publisher.ContractIpRange{ContractIpRangeId: "CIPRXXXXXX", IpRange: "192.0.2.0/25", Status: piano_publisher.ContractIpRangeStatusINVALID}
+	cases := []struct {
+		name            string
+		pages           [][]piano_publisher.ContractIpRange
+		expectedRemoved bool
+	}{
+		{name: "found", pages: [][]piano_publisher.ContractIpRange{append(otherIpRanges(2), target)}},
+		{name: "found on a later page", pages: [][]piano_publisher.ContractIpRange{otherIpRanges(100), {target}}},
+		{name: "not found", pages: [][]piano_publisher.ContractIpRange{otherIpRanges(2)}, expectedRemoved: true},
+		{name: "no ip ranges", pages: [][]piano_publisher.ContractIpRange{{}}, expectedRemoved: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			total := 0
+			for _, page := range c.pages {
+				total += len(page)
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/licensing/contractIpRange/list" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				query := req.URL.Query()
+				if query.Get("aid") != "example" || query.Get("contract_id") != "TMXXXXXX" {
+					t.Errorf("unexpected query: %s", req.URL.RawQuery)
+				}
+				page := []piano_publisher.ContractIpRange{}
+				offset := 0
+				for _, p := range c.pages {
+					if fmt.Sprint(offset) == query.Get("offset") {
+						page = p
+					}
+					offset += len(p)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "total": total, "ContractIpRange": page}); err != nil {
+					t.Fatal(err)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &ContractIpRangeResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, &ContractIpRangeResourceModel{
+				Aid:               types.StringValue("example"),
+				ContractId:        types.StringValue("TMXXXXXX"),
+				ContractIpRangeId: types.StringValue("CIPRXXXXXX"),
+				IpRange:           types.StringValue("192.0.2.0/24"),
+				Status:            types.StringValue("VALID"),
+			})
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != c.expectedRemoved {
+				t.Fatalf("expected resource to be removed: %t, got %v", c.expectedRemoved, resp.State.Raw)
+			}
+			if c.expectedRemoved {
+				return
+			}
+			var actual ContractIpRangeResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if actual.IpRange.ValueString() != "192.0.2.0/25" || actual.Status.ValueString() != "INVALID" {
+				t.Errorf("expected ip range to be refreshed, got %s %s", actual.IpRange, actual.Status)
+			}
+		})
+	}
+}
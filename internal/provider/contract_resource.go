@@ -11,6 +11,7 @@ import (
 	"strings"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -37,6 +38,7 @@ type ContractResourceModel struct {
 
 	// Computed
 	CreateDate       types.Int64  `tfsdk:"create_date"`
+	CreateDateIso    types.String `tfsdk:"create_date_iso"`
 	ContractIsActive types.Bool   `tfsdk:"contract_is_active"`
 	ScheduleId       types.String `tfsdk:"schedule_id"`
 	// Optional
@@ -49,6 +51,7 @@ type ContractResourceModel struct {
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &ContractResource{}
+	_ resource.ResourceWithModifyPlan  = &ContractResource{}
 	_ resource.ResourceWithImportState = &ContractResource{}
 )
 
@@ -58,7 +61,10 @@ func NewContractResource() resource.Resource {
 
 // ContractResource defines the resource implementation.
 type ContractResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
 }
 
 func (*ContractResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,10 +75,7 @@ func (*ContractResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Contract Resource. This resource is used to create, update, and delete a contract.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"contract_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The public ID of the contract",
@@ -90,7 +93,7 @@ func (*ContractResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"description": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The description of the contract",
+				MarkdownDescription: "The description of the contract. An empty string is kept as an empty string to clear the description, while an unset description is kept as null",
 			},
 			"contract_type": schema.StringAttribute{
 				Required:            true,
@@ -106,6 +109,7 @@ func (*ContractResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"create_date_iso": unixTimeIsoAttribute("The creation date of the contract"),
 			"landing_page_url": schema.StringAttribute{
 				Optional:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -138,22 +142,23 @@ func (*ContractResource) Schema(ctx context.Context, req resource.SchemaRequest,
 }
 
 func (r *ContractResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
+}
 
+func (r *ContractResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	r.client = &client.publisherClient
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "create_date")
 }
 
 func (r *ContractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -197,18 +202,14 @@ func (r *ContractResource) Create(ctx context.Context, req resource.CreateReques
 	// Computed
 	plan.ContractId = types.StringValue(result.Contract.ContractId)
 	plan.CreateDate = types.Int64Value(int64(result.Contract.CreateDate))
+	plan.CreateDateIso = unixTimeIsoFrom(plan.CreateDate, r.dateLocation)
 	plan.ContractIsActive = types.BoolValue(result.Contract.ContractIsActive)
 	// Updated
 	plan.ContractType = types.StringValue(string(result.Contract.ContractType))
 	plan.LicenseeId = types.StringValue(result.Contract.LicenseeId)
 	plan.Rid = types.StringValue(result.Contract.Rid)
 	plan.Name = types.StringValue(result.Contract.Name)
-	if plan.Description.IsNull() && result.Contract.Description != nil && *result.Contract.Description == "" {
-		result.Contract.Description = nil
-	} else {
-		plan.Description = types.StringPointerValue(result.Contract.Description)
-	}
-	plan.Description = types.StringPointerValue(result.Contract.Description)
+	plan.Description = syntax.ReconcileOptionalStringWithMode(plan.Description, result.Contract.Description, syntax.EmptyStringAsValue)
 	plan.IsHardSeatsLimitType = types.BoolValue(result.Contract.IsHardSeatsLimitType)
 	plan.SeatsNumber = types.Int32Value(result.Contract.SeatsNumber)
 	plan.LandingPageUrl = types.StringValue(result.Contract.LandingPageUrl)
@@ -247,11 +248,9 @@ func (r *ContractResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Populate state with the response data
 	state.Rid = types.StringValue(result.Contract.Rid)
 	state.Name = types.StringValue(result.Contract.Name)
-	if state.Description.IsNull() && result.Contract.Description != nil && *result.Contract.Description == "" {
-		result.Contract.Description = nil
-	}
-	state.Description = types.StringPointerValue(result.Contract.Description)
+	state.Description = syntax.ReconcileOptionalStringWithMode(state.Description, result.Contract.Description, syntax.EmptyStringAsValue)
 	state.CreateDate = types.Int64Value(int64(result.Contract.CreateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
 	state.ContractIsActive = types.BoolValue(result.Contract.ContractIsActive)
 	state.ContractType = types.StringValue(string(result.Contract.ContractType))
 	state.LandingPageUrl = types.StringValue(result.Contract.LandingPageUrl)
@@ -311,15 +310,13 @@ func (r *ContractResource) Update(ctx context.Context, req resource.UpdateReques
 	// Computed
 	state.ContractIsActive = types.BoolValue(result.Contract.ContractIsActive)
 	state.CreateDate = types.Int64Value(int64(result.Contract.CreateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
 	// Updatable
 	state.LicenseeId = types.StringValue(result.Contract.LicenseeId)
 	state.ContractType = types.StringValue(string(result.Contract.ContractType))
 	state.Rid = types.StringValue(result.Contract.Rid)
 	state.Name = types.StringValue(result.Contract.Name)
-	if state.Description.IsNull() && result.Contract.Description != nil && *result.Contract.Description == "" {
-		result.Contract.Description = nil
-	}
-	state.Description = types.StringPointerValue(result.Contract.Description)
+	state.Description = syntax.ReconcileOptionalStringWithMode(state.Description, result.Contract.Description, syntax.EmptyStringAsValue)
 	state.SeatsNumber = types.Int32Value(result.Contract.SeatsNumber)
 	state.IsHardSeatsLimitType = types.BoolValue(result.Contract.IsHardSeatsLimitType)
 	state.SeatsNumber = types.Int32Value(result.Contract.SeatsNumber)
@@ -346,7 +343,7 @@ func (r *ContractResource) Delete(ctx context.Context, req resource.DeleteReques
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contract, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,282 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ContractUserResourceModel describes the resource data model.
+type ContractUserResourceModel struct {
+	Aid            types.String `tfsdk:"aid"`              // The application ID
+	ContractId     types.String `tfsdk:"contract_id"`      // The public ID of the contract
+	ContractUserId types.String `tfsdk:"contract_user_id"` // The contract user's public ID
+	Email          types.String `tfsdk:"email"`            // The user's email address (single)
+	FirstName      types.String `tfsdk:"first_name"`       // The user's first name
+	LastName       types.String `tfsdk:"last_name"`        // The user's last name
+	Status         types.String `tfsdk:"status"`           // The status of the user access redemption
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ContractUserResource{}
+	_ resource.ResourceWithModifyPlan  = &ContractUserResource{}
+	_ resource.ResourceWithImportState = &ContractUserResource{}
+)
+
+func NewContractUserResource() resource.Resource {
+	return &ContractUserResource{}
+}
+
+// ContractUserResource defines the resource implementation.
+type ContractUserResource struct {
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+}
+
+func (*ContractUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contract_user"
+}
+
+func (*ContractUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "ContractUser Resource. This resource is used to grant access to a user with the email address through a `SPECIFIC_EMAIL_ADDRESSES_CONTRACT` contract. " +
+			"Use `piano_contract_domain` for `EMAIL_DOMAIN_CONTRACT` contracts and `piano_contract_ip_range` for `IP_RANGE_CONTRACT` contracts.",
+		Attributes: map[string]schema.Attribute{
+			"aid": defaultAidAttribute(),
+			"contract_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The public ID of the contract. The contract type must be `SPECIFIC_EMAIL_ADDRESSES_CONTRACT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contract_user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The contract user's public ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The user's email address (single)",
+			},
+			"first_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The user's first name",
+			},
+			"last_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The user's last name",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the user access redemption",
+			},
+		},
+	}
+}
+
+func (r *ContractUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *ContractUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+}
+
+func (r *ContractUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContractUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating contract user %s in %s", plan.Email.ValueString(), plan.ContractId.ValueString()))
+
+	response, err := r.client.PostPublisherLicensingContractUserCreateWithFormdataBody(ctx, piano_publisher.PostPublisherLicensingContractUserCreateFormdataRequestBody{
+		Aid:        plan.Aid.ValueString(),
+		ContractId: plan.ContractId.ValueString(),
+		Email:      plan.Email.ValueString(),
+		FirstName:  plan.FirstName.ValueStringPointer(),
+		LastName:   plan.LastName.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create contract user, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.ContractUserResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	plan.ContractUserId = types.StringValue(result.ContractUser.ContractUserId)
+	plan.Status = types.StringValue(string(result.ContractUser.Status))
+	tflog.Info(ctx, fmt.Sprintf("complete creating contract user %s(id: %s)", plan.Email, plan.ContractUserId))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContractUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContractUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	users, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.ContractUser, int, error) {
+		params := piano_publisher.GetPublisherLicensingContractUserListParams{
+			Aid:        state.Aid.ValueString(),
+			ContractId: state.ContractId.ValueString(),
+			Offset:     int32(offset),
+			Limit:      int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching users of contract %s (offset: %d, limit: %d)", params.ContractId, params.Offset, params.Limit))
+		response, err := r.client.GetPublisherLicensingContractUserList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch contract users, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.ContractUserArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.ContractUser, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	for _, item := range users {
+		if item.ContractUserId != state.ContractUserId.ValueString() {
+			continue
+		}
+		state.Email = types.StringValue(item.Email)
+		state.FirstName = syntax.ReconcileOptionalString(state.FirstName, &item.FirstName)
+		state.LastName = syntax.ReconcileOptionalString(state.LastName, &item.LastName)
+		state.Status = types.StringValue(string(item.Status))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("contract user %s is not found in contract %s. removing it from state", state.ContractUserId.ValueString(), state.ContractId.ValueString()))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ContractUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContractUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.PostPublisherLicensingContractUserUpdateWithFormdataBody(ctx, piano_publisher.PostPublisherLicensingContractUserUpdateFormdataRequestBody{
+		Aid:            plan.Aid.ValueString(),
+		ContractId:     plan.ContractId.ValueString(),
+		ContractUserId: plan.ContractUserId.ValueString(),
+		Email:          plan.Email.ValueString(),
+		FirstName:      plan.FirstName.ValueStringPointer(),
+		LastName:       plan.LastName.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update contract user, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.ContractUserResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	plan.Status = types.StringValue(string(result.ContractUser.Status))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContractUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContractUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.PostPublisherLicensingContractUserRemoveWithFormdataBody(ctx, piano_publisher.PostPublisherLicensingContractUserRemoveFormdataRequestBody{
+		Aid:            state.Aid.ValueString(),
+		ContractId:     state.ContractId.ValueString(),
+		ContractUserId: state.ContractUserId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contract user, got error: %s", err))
+		return
+	}
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+}
+
+func (r *ContractUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceId, err := ContractUserResourceIdFromString(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ContractUser resource id", fmt.Sprintf("Unable to parse contract user resource id, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aid"), resourceId.Aid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("contract_id"), resourceId.ContractId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("contract_user_id"), resourceId.ContractUserId)...)
+}
+
+// ContractUserResourceId represents a piano.io contract user identifier in "{aid}/{contract_id}/{contract_user_id}" format.
+type ContractUserResourceId struct {
+	Aid            string
+	ContractId     string
+	ContractUserId string
+}
+
+func ContractUserResourceIdFromString(input string) (*ContractUserResourceId, error) {
+	parts := strings.Split(input, "/")
+	if len(parts) != 3 {
+		return nil, errors.New("contract user id must be in {aid}/{contract_id}/{contract_user_id} format")
+	}
+	return &ContractUserResourceId{Aid: parts[0], ContractId: parts[1], ContractUserId: parts[2]}, nil
+}
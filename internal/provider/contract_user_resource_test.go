@@ -0,0 +1,111 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestContractUserResourceRead(t *testing.T) {
+	cases := []struct {
+		name              string
+		response          string
+		firstName         types.String
+		expectedRemoved   bool
+		expectedFirstName types.String
+	}{
+		{
+			name:              "found",
+			response:          `{"code":0,"total":2,"ContractUser":[{"contract_user_id":"CUOTHER","email":"other@example.com","first_name":"","last_name":"","status":"active"},{"contract_user_id":"CUXXXXXX","email":"member@example.com","first_name":"Member","last_name":"","status":"active"}]}`,
+			firstName:         types.StringValue("Example"),
+			expectedFirstName: types.StringValue("Member"),
+		},
+		{
+			name:              "unset first name",
+			response:          `{"code":0,"total":1,"ContractUser":[{"contract_user_id":"CUXXXXXX","email":"member@example.com","first_name":"","last_name":"","status":"active"}]}`,
+			firstName:         types.StringNull(),
+			expectedFirstName: types.StringNull(),
+		},
+		{
+			name:            "not found",
+			response:        `{"code":0,"total":1,"ContractUser":[{"contract_user_id":"CUOTHER","email":"other@example.com","first_name":"","last_name":"","status":"active"}]}`,
+			firstName:       types.StringNull(),
+			expectedRemoved: true,
+		},
+		{
+			name:            "no users",
+			response:        `{"code":0,"total":0,"ContractUser":[]}`,
+			firstName:       types.StringNull(),
+			expectedRemoved: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/licensing/contractUser/list" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				query := req.URL.Query()
+				if query.Get("aid") != "example" || query.Get("contract_id") != "TMXXXXXX" {
+					t.Errorf("unexpected query: %s", req.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &ContractUserResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, &ContractUserResourceModel{
+				Aid:            types.StringValue("example"),
+				ContractId:     types.StringValue("TMXXXXXX"),
+				ContractUserId: types.StringValue("CUXXXXXX"),
+				Email:          types.StringValue("member@example.com"),
+				FirstName:      c.firstName,
+				LastName:       types.StringNull(),
+				Status:         types.StringValue("pending"),
+			})
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != c.expectedRemoved {
+				t.Fatalf("expected resource to be removed: %t, got %v", c.expectedRemoved, resp.State.Raw)
+			}
+			if c.expectedRemoved {
+				return
+			}
+			var actual ContractUserResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !actual.FirstName.Equal(c.expectedFirstName) {
+				t.Errorf("expected first_name to be %s, got %s", c.expectedFirstName, actual.FirstName)
+			}
+			if !actual.LastName.IsNull() {
+				t.Errorf("expected last_name to be kept null, got %s", actual.LastName)
+			}
+			if actual.Status.ValueString() != "active" {
+				t.Errorf("expected status to be active, got %s", actual.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ConversionDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConversionDataSource{}
+)
+
+func NewConversionDataSource() datasource.DataSource {
+	return &ConversionDataSource{}
+}
+
+// ConversionDataSource defines the data source implementation.
+type ConversionDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+// ConversionDataSourceModel describes the data source data model.
+type ConversionDataSourceModel struct {
+	Aid          types.String                `tfsdk:"aid"`            // The application ID
+	TermId       types.String                `tfsdk:"term_id"`        // The term ID
+	DateFrom     types.Int64                 `tfsdk:"date_from"`      // The start of the date range
+	DateTo       types.Int64                 `tfsdk:"date_to"`        // The end of the date range
+	Limit        types.Int32                 `tfsdk:"limit"`          // The maximum number of conversions to list
+	TotalCount   types.Int64                 `tfsdk:"total_count"`    // The number of conversions of the term
+	CountsByType types.Map                   `tfsdk:"counts_by_type"` // The number of conversions of the term per conversion type
+	Conversions  []ConversionDataSourceEntry `tfsdk:"conversions"`    // The conversions of the term, most recent first
+}
+
+type ConversionDataSourceEntry struct {
+	TermConversionId types.String `tfsdk:"term_conversion_id"` // The term conversion ID
+	Type             types.String `tfsdk:"type"`               // The term conversion type
+	CreateDate       types.Int64  `tfsdk:"create_date"`        // The creation date
+	Uid              types.String `tfsdk:"uid"`                // The user ID
+}
+
+func (*ConversionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversion"
+}
+
+func (*ConversionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Conversion data source. This data source is used to report on conversions of a term.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				MarkdownDescription: "The application ID",
+				Required:            true,
+				Validators:          aidValidators(),
+			},
+			"term_id": schema.StringAttribute{
+				MarkdownDescription: "The term ID",
+				Required:            true,
+			},
+			"date_from": schema.Int64Attribute{
+				MarkdownDescription: "The start of the date range (epoch seconds)",
+				Optional:            true,
+			},
+			"date_to": schema.Int64Attribute{
+				MarkdownDescription: "The end of the date range (epoch seconds)",
+				Optional:            true,
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "The maximum number of the most recent conversions of the term to list in `conversions`. " +
+					"`total_count` and `counts_by_type` always count all the conversions of the term. All the conversions are listed when this value is null.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of conversions of the term",
+				Computed:            true,
+			},
+			"counts_by_type": schema.MapAttribute{
+				MarkdownDescription: "The number of conversions of the term per conversion type",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"conversions": schema.ListNestedAttribute{
+				MarkdownDescription: "The conversions of the term, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"term_conversion_id": schema.StringAttribute{
+							MarkdownDescription: "The term conversion ID",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The term conversion type",
+							Computed:            true,
+						},
+						"create_date": schema.Int64Attribute{
+							MarkdownDescription: "The creation date",
+							Computed:            true,
+						},
+						"uid": schema.StringAttribute{
+							MarkdownDescription: "The user ID",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConversionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.publisherClient
+}
+
+func (d *ConversionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConversionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dateFrom, dateTo *int
+	if !state.DateFrom.IsNull() {
+		date := int(state.DateFrom.ValueInt64())
+		dateFrom = &date
+	}
+	if !state.DateTo.IsNull() {
+		date := int(state.DateTo.ValueInt64())
+		dateTo = &date
+	}
+
+	// The endpoint does not filter by term, so all the conversions in the date range are fetched and filtered afterwards.
+	fetched, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.TermConversionDTO, int, error) {
+		params := piano_publisher.GetPublisherConversionListParams{
+			Aid:      state.Aid.ValueString(),
+			DateFrom: dateFrom,
+			DateTo:   dateTo,
+			Offset:   int32(offset),
+			Limit:    int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching conversions in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := d.client.GetPublisherConversionList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch conversions, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.TermConversionDTOArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.Conversions, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	conversions := []piano_publisher.TermConversionDTO{}
+	for _, conversion := range fetched {
+		if conversion.Term.TermId == state.TermId.ValueString() {
+			conversions = append(conversions, conversion)
+		}
+	}
+
+	sort.SliceStable(conversions, func(i, j int) bool {
+		return conversions[i].CreateDate > conversions[j].CreateDate
+	})
+	countsByType := map[string]int64{}
+	entries := []ConversionDataSourceEntry{}
+	for i, conversion := range conversions {
+		countsByType[string(conversion.Type)] += 1
+		if !state.Limit.IsNull() && i >= int(state.Limit.ValueInt32()) {
+			continue
+		}
+		entries = append(entries, ConversionDataSourceEntry{
+			TermConversionId: types.StringValue(conversion.TermConversionId),
+			Type:             types.StringValue(string(conversion.Type)),
+			CreateDate:       types.Int64Value(int64(conversion.CreateDate)),
+			Uid:              types.StringValue(conversion.UserAccess.User.Uid),
+		})
+	}
+	countsByTypeValue, diags := types.MapValueFrom(ctx, types.Int64Type, countsByType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.TotalCount = types.Int64Value(int64(len(conversions)))
+	state.CountsByType = countsByTypeValue
+	state.Conversions = entries
+	tflog.Trace(ctx, "read a conversion data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConversionDataSourceRead(t *testing.T) {
+	// 250 conversions over three pages, where every other conversion belongs to the term.
+	const total = 250
+	conversionAt := func(i int) map[string]any {
+		termId, conversionType := "TM2", "Payment"
+		if i%2 == 0 {
+			termId = "TM1"
+			if i%4 == 0 {
+				conversionType = "Bill"
+			}
+		}
+		return map[string]any{
+			"term_conversion_id": fmt.Sprintf("TC%d", i),
+			"type":               conversionType,
+			"create_date":        1735657200 + i,
+			"term":               map[string]any{"term_id": termId},
+			"user_access":        map[string]any{"user": map[string]any{"uid": fmt.Sprintf("U%d", i)}},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		limit    types.Int32
+		expected []string
+	}{
+		{name: "limited", limit: types.Int32Value(2), expected: []string{"TC248", "TC246"}},
+		{name: "unlimited", limit: types.Int32Null()},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/conversion/list" {
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+				if req.URL.Query().Get("date_from") != "1735657200" {
+					t.Errorf("expected date_from to be sent, got %s", req.URL.RawQuery)
+				}
+				offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+				limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+				conversions := []map[string]any{}
+				for i := offset; i < min(offset+limit, total); i++ {
+					conversions = append(conversions, conversionAt(i))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "conversions": conversions, "total": total}); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &ConversionDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &ConversionDataSourceModel{
+				Aid:          types.StringValue("example"),
+				TermId:       types.StringValue("TM1"),
+				DateFrom:     types.Int64Value(1735657200),
+				DateTo:       types.Int64Null(),
+				Limit:        c.limit,
+				TotalCount:   types.Int64Null(),
+				CountsByType: types.MapNull(types.Int64Type),
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual ConversionDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			// limit only bounds the listed conversions; the counts cover all the conversions of the term.
+			if actual.TotalCount.ValueInt64() != 125 {
+				t.Errorf("expected 125 conversions of the term, got %s", actual.TotalCount)
+			}
+			countsByType := map[string]int64{}
+			resp.Diagnostics.Append(actual.CountsByType.ElementsAs(ctx, &countsByType, false)...)
+			if countsByType["Bill"] != 63 || countsByType["Payment"] != 62 || len(countsByType) != 2 {
+				t.Errorf("unexpected counts by type: %v", countsByType)
+			}
+			if c.limit.IsNull() {
+				if len(actual.Conversions) != 125 {
+					t.Errorf("expected all the conversions of the term, got %d", len(actual.Conversions))
+				}
+				return
+			}
+			ids := []string{}
+			for _, conversion := range actual.Conversions {
+				ids = append(ids, conversion.TermConversionId.ValueString())
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+				t.Errorf("expected the most recent conversions %v, got %v", c.expected, ids)
+			}
+		})
+	}
+}
@@ -4,14 +4,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"terraform-provider-piano/internal/piano_id"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,32 +27,33 @@ import (
 )
 
 var (
-	_ resource.Resource = &CustomFieldResource{}
+	_ resource.Resource                   = &CustomFieldResource{}
+	_ resource.ResourceWithModifyPlan     = &CustomFieldResource{}
+	_ resource.ResourceWithValidateConfig = &CustomFieldResource{}
+	_ resource.ResourceWithUpgradeState   = &CustomFieldResource{}
 )
 
 type CustomFieldResource struct {
-	client *piano_id.Client
+	client     *piano_id.Client
+	defaultAid types.String
 }
 
 func NewCustomFieldResource() resource.Resource {
 	return &CustomFieldResource{}
 }
 func (r *CustomFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.idClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *CustomFieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (r *CustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,63 +61,55 @@ func (r *CustomFieldResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 type CustomFieldResourceModel struct {
-	Aid                  types.String           `tfsdk:"aid"` // The application ID
-	FieldName            types.String           `tfsdk:"field_name"`
-	Title                types.String           `tfsdk:"title"`
-	Comment              types.String           `tfsdk:"comment"`
-	Editable             types.Bool             `tfsdk:"editable"`
-	DataType             types.String           `tfsdk:"data_type"`
-	Options              *[]types.String        `tfsdk:"options"`
-	RequiredByDefault    types.Bool             `tfsdk:"required_by_default"`
-	Archived             types.Bool             `tfsdk:"archived"`
-	DefaultSortOrder     types.Int32            `tfsdk:"default_sort_order"`
-	DefaultValue         types.String           `tfsdk:"default_value"`
-	Prechecked           types.Bool             `tfsdk:"prechecked"`
-	Placeholder          types.String           `tfsdk:"placeholder"`
-	DateFormat           types.String           `tfsdk:"date_format"`
-	Global               types.Bool             `tfsdk:"global"`
-	Multiline            types.Bool             `tfsdk:"multiline"`
-	PreSelectCountryById types.Bool             `tfsdk:"pre_select_country_by_ip"`
-	Validators           *[]types.String        `tfsdk:"validators"`
-	LengthValidator      *StringLengthValidator `tfsdk:"length_validator"`
-	RegexValidator       *RegexValidator        `tfsdk:"regex_validator"`
-	EmailValidator       *EmailValidator        `tfsdk:"email_validator"`
-	AllowListValidator   *AllowListValidator    `tfsdk:"allow_list_validator"`
-	DenyListValidator    *DenyListValidator     `tfsdk:"deny_list_validator"`
-}
-
-type StringLengthValidator struct {
-	MinLength    types.Int32  `tfsdk:"min_length"`
-	MaxLength    types.Int32  `tfsdk:"max_length"`
-	ErrorMessage types.String `tfsdk:"error_message"`
-}
-type RegexValidator struct {
-	Pattern      types.String `tfsdk:"pattern"`
-	ErrorMessage types.String `tfsdk:"error_message"`
-}
-type EmailValidator struct {
-	ErrorMessage types.String `tfsdk:"error_message"`
+	Aid                  types.String                `tfsdk:"aid"` // The application ID
+	FieldName            types.String                `tfsdk:"field_name"`
+	Title                types.String                `tfsdk:"title"`
+	Comment              types.String                `tfsdk:"comment"`
+	Editable             types.Bool                  `tfsdk:"editable"`
+	DataType             types.String                `tfsdk:"data_type"`
+	Options              *[]types.String             `tfsdk:"options"`
+	RequiredByDefault    types.Bool                  `tfsdk:"required_by_default"`
+	Archived             types.Bool                  `tfsdk:"archived"`
+	DefaultSortOrder     types.Int32                 `tfsdk:"default_sort_order"`
+	DefaultValue         types.String                `tfsdk:"default_value"`
+	Prechecked           types.Bool                  `tfsdk:"prechecked"`
+	Placeholder          types.String                `tfsdk:"placeholder"`
+	DateFormat           types.String                `tfsdk:"date_format"`
+	Global               types.Bool                  `tfsdk:"global"`
+	Multiline            types.Bool                  `tfsdk:"multiline"`
+	PreSelectCountryById types.Bool                  `tfsdk:"pre_select_country_by_ip"`
+	TooltipText          types.String                `tfsdk:"tooltip_text"`
+	TooltipType          types.String                `tfsdk:"tooltip_type"`
+	Validators           []CustomFieldValidatorModel `tfsdk:"validators"`
 }
 
-type AllowListValidator struct {
-	Items        []types.String `tfsdk:"items"`
-	ErrorMessage types.String   `tfsdk:"error_message"`
+// CustomFieldValidatorModel is a validator of a custom field. Parameters which do not apply to Type are null.
+type CustomFieldValidatorModel struct {
+	Type         types.String   `tfsdk:"type"`          // The validator type
+	MinLength    types.Int32    `tfsdk:"min_length"`    // The minimum length of the input for STR_LENGTH
+	MaxLength    types.Int32    `tfsdk:"max_length"`    // The maximum length of the input for STR_LENGTH
+	Pattern      types.String   `tfsdk:"pattern"`       // The regular expression for REGEXP
+	Items        []types.String `tfsdk:"items"`         // The allowed or denied inputs for WHITELIST and BLACKLIST
+	ErrorMessage types.String   `tfsdk:"error_message"` // The error message
 }
 
-type DenyListValidator struct {
-	Items        []types.String `tfsdk:"items"`
-	ErrorMessage types.String   `tfsdk:"error_message"`
+// customFieldValidatorTypes are validator types supported by this provider.
+var customFieldValidatorTypes = []string{
+	string(piano_id.STRLENGTH),
+	string(piano_id.REGEXP),
+	string(piano_id.EMAIL),
+	string(piano_id.WHITELIST),
+	string(piano_id.BLACKLIST),
 }
 
 func (*CustomFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: "This is a custom field resource. This resource is unsafe in that it always creates or updates resources" +
-			" because piano id API does not provide a way of getting custom field without mutating it.",
+			" because piano id API does not provide a way of getting custom field without mutating it. " +
+			"Destroying this resource archives the custom field instead of deleting it.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"field_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -157,8 +157,12 @@ func (*CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Piano ID custom field archive status(default: false)",
 			},
 			"archived": schema.BoolAttribute{
-				Computed:            true,
-				MarkdownDescription: "Piano ID custom field archive status(default: false)",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "Piano ID custom field archive status(default: false). " +
+					"Set this to true to archive the custom field without destroying the resource, and back to false to restore it. " +
+					"piano id API does not delete custom fields, so destroying the resource archives the custom field as well.",
 			},
 			"default_sort_order": schema.Int32Attribute{
 				Optional:            true,
@@ -205,62 +209,310 @@ func (*CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				MarkdownDescription: "Whether or not select country by ip for country field. Default is false.",
 			},
-			"validators": schema.ListAttribute{
-				ElementType:         types.StringType,
+			"tooltip_text": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Piano ID custom field validators",
-			},
-			"length_validator": schema.ObjectAttribute{
-				Optional: true,
-				MarkdownDescription: "Check if the input length fits between the min_length and max_length.\n" +
-					"Any user with a response outside of this range will be shown the error message you configure.",
-				AttributeTypes: map[string]attr.Type{
-					"min_length":    types.Int32Type,
-					"max_length":    types.Int32Type,
-					"error_message": types.StringType,
-				},
+				MarkdownDescription: "The tooltip shown next to the field to explain it to end users. The tooltip is disabled when this is not set.",
 			},
-			"regex_validator": schema.ObjectAttribute{
-				Optional: true,
-				MarkdownDescription: "Check if the input matches the given regular expression.\n" +
-					"You may specify the type of characters you would like to support as well as any particular format that is required.",
-				AttributeTypes: map[string]attr.Type{
-					"pattern":       types.StringType,
-					"error_message": types.StringType,
-				},
+			"tooltip_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The tooltip type",
 			},
-			"email_validator": schema.ObjectAttribute{
+			"validators": schema.ListNestedAttribute{
 				Optional: true,
-				MarkdownDescription: "Check if the input conforms to valid email format.\n" +
-					"Checking this box ensures that the content entered by the user is in the form of an email address, meaning it contains an '@' symbol and ends in a top-level domain name.",
-				AttributeTypes: map[string]attr.Type{
-					"error_message": types.StringType,
-				},
-			},
-			"allow_list_validator": schema.ObjectAttribute{
-				Optional:            true,
-				MarkdownDescription: "Specify the allow list of possible inputs",
-				AttributeTypes: map[string]attr.Type{
-					"items": types.ListType{
-						ElemType: types.StringType,
+				MarkdownDescription: "Piano ID custom field validators. A field may have multiple validators, including ones of the same type. " +
+					"Which parameters apply depends on `type`:\n" +
+					"  - STR_LENGTH: Check if the input length fits between `min_length` and `max_length`.\n" +
+					"  - REGEXP: Check if the input matches the regular expression `pattern`.\n" +
+					"  - EMAIL: Check if the input conforms to valid email format, i.e. it contains an '@' symbol and ends in a top-level domain name.\n" +
+					"  - WHITELIST: Check if the input is one of `items`.\n" +
+					"  - BLACKLIST: Check if the input is none of `items`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The validator type",
+							Validators: []validator.String{
+								stringvalidator.OneOf(customFieldValidatorTypes...),
+							},
+						},
+						"min_length": schema.Int32Attribute{
+							Optional:            true,
+							MarkdownDescription: "The minimum length of the input for STR_LENGTH validator",
+						},
+						"max_length": schema.Int32Attribute{
+							Optional:            true,
+							MarkdownDescription: "The maximum length of the input for STR_LENGTH validator",
+						},
+						"pattern": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The regular expression for REGEXP validator",
+						},
+						"items": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "The allowed inputs for WHITELIST validator or the denied inputs for BLACKLIST validator",
+						},
+						"error_message": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The error message shown to the user whose input does not pass the validator",
+						},
 					},
-					"error_message": types.StringType,
 				},
 			},
-			"deny_list_validator": schema.ObjectAttribute{
-				Optional:            true,
-				MarkdownDescription: "Specify the deny list of possible inputs",
-				AttributeTypes: map[string]attr.Type{
-					"items": types.ListType{
-						ElemType: types.StringType,
-					},
-					"error_message": types.StringType,
-				},
+		},
+	}
+}
+
+// customFieldValidatorParams lists parameters applicable to each validator type and the one the type requires, if any.
+var customFieldValidatorParams = map[string]struct {
+	applicable []string
+	required   string
+}{
+	string(piano_id.STRLENGTH): {applicable: []string{"min_length", "max_length"}},
+	string(piano_id.REGEXP):    {applicable: []string{"pattern"}, required: "pattern"},
+	string(piano_id.EMAIL):     {},
+	string(piano_id.WHITELIST): {applicable: []string{"items"}, required: "items"},
+	string(piano_id.BLACKLIST): {applicable: []string{"items"}, required: "items"},
+}
+
+// customFieldDataTypeParams lists attributes that only apply to some data types and the data types they apply to.
+var customFieldDataTypeParams = []struct {
+	name      string
+	dataTypes []piano_id.CustomFieldDefinitionDataType
+}{
+	{name: "date_format", dataTypes: []piano_id.CustomFieldDefinitionDataType{piano_id.ISODATE}},
+	{name: "options", dataTypes: []piano_id.CustomFieldDefinitionDataType{piano_id.SINGLESELECTLIST, piano_id.MULTISELECTLIST}},
+	{name: "multiline", dataTypes: []piano_id.CustomFieldDefinitionDataType{piano_id.TEXT}},
+}
+
+func (r *CustomFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.validateDataTypeParams(ctx, req, resp)
+	r.validateDefaultValue(ctx, req, resp)
+
+	var validators types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validators"), &validators)...)
+	if resp.Diagnostics.HasError() || validators.IsNull() || validators.IsUnknown() {
+		return
+	}
+	for i, element := range validators.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		attributes := object.Attributes()
+		validatorType, ok := attributes["type"].(types.String)
+		if !ok || validatorType.IsUnknown() {
+			continue
+		}
+		params, ok := customFieldValidatorParams[validatorType.ValueString()]
+		if !ok {
+			continue
+		}
+		for _, name := range []string{"min_length", "max_length", "pattern", "items"} {
+			value := attributes[name]
+			if name == params.required && value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("validators").AtListIndex(i).AtName(name),
+					"Missing Validator Parameter",
+					fmt.Sprintf("%s validator requires %s.", validatorType.ValueString(), name),
+				)
+			}
+			if !slices.Contains(params.applicable, name) && !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("validators").AtListIndex(i).AtName(name),
+					"Invalid Validator Parameter",
+					fmt.Sprintf("%s does not apply to %s validator.", name, validatorType.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+// validateDataTypeParams reports attributes set for a data_type they do not apply to, which piano.io ignores silently.
+func (*CustomFieldResource) validateDataTypeParams(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var dataType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_type"), &dataType)...)
+	if resp.Diagnostics.HasError() || dataType.IsNull() || dataType.IsUnknown() {
+		return
+	}
+	for _, param := range customFieldDataTypeParams {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(param.name), &value)...)
+		if value == nil || value.IsNull() || slices.Contains(param.dataTypes, piano_id.CustomFieldDefinitionDataType(dataType.ValueString())) {
+			continue
+		}
+		dataTypes := []string{}
+		for _, applicable := range param.dataTypes {
+			dataTypes = append(dataTypes, string(applicable))
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(param.name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s is only valid when data_type is %s, got %s.", param.name, strings.Join(dataTypes, " or "), dataType.ValueString()),
+		)
+	}
+}
+
+// customFieldDateLayouts converts tokens of date_format into Go time layout.
+var customFieldDateLayouts = strings.NewReplacer("yyyy", "2006", "mm", "01", "dd", "02")
+
+// validateDefaultValue reports default_value that does not parse as a value of data_type, which piano.io rejects on apply.
+func (*CustomFieldResource) validateDefaultValue(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var dataType, defaultValue, dateFormat types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_type"), &dataType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_value"), &defaultValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("date_format"), &dateFormat)...)
+	if resp.Diagnostics.HasError() || dataType.IsUnknown() || defaultValue.IsNull() || defaultValue.IsUnknown() {
+		return
+	}
+	value := defaultValue.ValueString()
+	var detail string
+	switch dataType.ValueString() {
+	case "NUMBER":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			detail = fmt.Sprintf("default_value of NUMBER field must be an integer, got %q.", value)
+		}
+	case string(piano_id.BOOLEAN):
+		if value != "true" && value != "false" {
+			detail = fmt.Sprintf("default_value of BOOLEAN field must be true or false, got %q.", value)
+		}
+	case string(piano_id.ISODATE):
+		// The date cannot be checked without knowing its format.
+		if dateFormat.IsNull() || dateFormat.IsUnknown() {
+			return
+		}
+		if _, err := time.Parse(customFieldDateLayouts.Replace(dateFormat.ValueString()), value); err != nil {
+			detail = fmt.Sprintf("default_value of ISO_DATE field must be a date in date_format %s, got %q.", dateFormat.ValueString(), value)
+		}
+	}
+	if detail != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("default_value"), "Invalid Default Value", detail)
+	}
+}
+
+// customFieldResourceModelV0 is the state of version 0, which has one optional object per validator type.
+type customFieldResourceModelV0 struct {
+	Aid                  *string   `json:"aid"`
+	FieldName            *string   `json:"field_name"`
+	Title                *string   `json:"title"`
+	Comment              *string   `json:"comment"`
+	Editable             *bool     `json:"editable"`
+	DataType             *string   `json:"data_type"`
+	Options              *[]string `json:"options"`
+	RequiredByDefault    *bool     `json:"required_by_default"`
+	Archived             *bool     `json:"archived"`
+	DefaultSortOrder     *int32    `json:"default_sort_order"`
+	DefaultValue         *string   `json:"default_value"`
+	Prechecked           *bool     `json:"prechecked"`
+	Placeholder          *string   `json:"placeholder"`
+	DateFormat           *string   `json:"date_format"`
+	Global               *bool     `json:"global"`
+	Multiline            *bool     `json:"multiline"`
+	PreSelectCountryById *bool     `json:"pre_select_country_by_ip"`
+	LengthValidator      *struct {
+		MinLength    *int32  `json:"min_length"`
+		MaxLength    *int32  `json:"max_length"`
+		ErrorMessage *string `json:"error_message"`
+	} `json:"length_validator"`
+	RegexValidator *struct {
+		Pattern      *string `json:"pattern"`
+		ErrorMessage *string `json:"error_message"`
+	} `json:"regex_validator"`
+	EmailValidator *struct {
+		ErrorMessage *string `json:"error_message"`
+	} `json:"email_validator"`
+	AllowListValidator *struct {
+		Items        *[]string `json:"items"`
+		ErrorMessage *string   `json:"error_message"`
+	} `json:"allow_list_validator"`
+	DenyListValidator *struct {
+		Items        *[]string `json:"items"`
+		ErrorMessage *string   `json:"error_message"`
+	} `json:"deny_list_validator"`
+}
+
+func (r *CustomFieldResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior customFieldResourceModelV0
+				if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to decode version 0 state, got error: %s", err))
+					return
+				}
+				upgraded := customFieldResourceModelFromV0(prior)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
 			},
 		},
 	}
 }
 
+// customFieldResourceModelFromV0 converts version 0 state into the current model.
+// Validator objects are collected into validators in the order they used to be sent to piano id API.
+// The list of strings formerly stored in validators was never sent to piano id API and is dropped.
+func customFieldResourceModelFromV0(data customFieldResourceModelV0) CustomFieldResourceModel {
+	ret := CustomFieldResourceModel{}
+	ret.Aid = types.StringPointerValue(data.Aid)
+	ret.FieldName = types.StringPointerValue(data.FieldName)
+	ret.Title = types.StringPointerValue(data.Title)
+	ret.Comment = types.StringPointerValue(data.Comment)
+	ret.Editable = types.BoolPointerValue(data.Editable)
+	ret.DataType = types.StringPointerValue(data.DataType)
+	if data.Options != nil {
+		options := customFieldValidatorItemsFrom(data.Options)
+		ret.Options = &options
+	}
+	ret.RequiredByDefault = types.BoolPointerValue(data.RequiredByDefault)
+	ret.Archived = types.BoolPointerValue(data.Archived)
+	ret.DefaultSortOrder = types.Int32PointerValue(data.DefaultSortOrder)
+	ret.DefaultValue = types.StringPointerValue(data.DefaultValue)
+	ret.Prechecked = types.BoolPointerValue(data.Prechecked)
+	ret.Placeholder = types.StringPointerValue(data.Placeholder)
+	ret.DateFormat = types.StringPointerValue(data.DateFormat)
+	ret.Global = types.BoolPointerValue(data.Global)
+	ret.Multiline = types.BoolPointerValue(data.Multiline)
+	ret.PreSelectCountryById = types.BoolPointerValue(data.PreSelectCountryById)
+
+	validators := []CustomFieldValidatorModel{}
+	newValidator := func(validatorType piano_id.ValidatorType, errorMessage *string) CustomFieldValidatorModel {
+		return CustomFieldValidatorModel{
+			Type:         types.StringValue(string(validatorType)),
+			MinLength:    types.Int32Null(),
+			MaxLength:    types.Int32Null(),
+			Pattern:      types.StringNull(),
+			ErrorMessage: types.StringPointerValue(errorMessage),
+		}
+	}
+	if v := data.LengthValidator; v != nil {
+		validator := newValidator(piano_id.STRLENGTH, v.ErrorMessage)
+		validator.MinLength = types.Int32PointerValue(v.MinLength)
+		validator.MaxLength = types.Int32PointerValue(v.MaxLength)
+		validators = append(validators, validator)
+	}
+	if v := data.RegexValidator; v != nil {
+		validator := newValidator(piano_id.REGEXP, v.ErrorMessage)
+		validator.Pattern = types.StringPointerValue(v.Pattern)
+		validators = append(validators, validator)
+	}
+	if v := data.EmailValidator; v != nil {
+		validators = append(validators, newValidator(piano_id.EMAIL, v.ErrorMessage))
+	}
+	if v := data.AllowListValidator; v != nil {
+		validator := newValidator(piano_id.WHITELIST, v.ErrorMessage)
+		validator.Items = customFieldValidatorItemsFrom(v.Items)
+		validators = append(validators, validator)
+	}
+	if v := data.DenyListValidator; v != nil {
+		validator := newValidator(piano_id.BLACKLIST, v.ErrorMessage)
+		validator.Items = customFieldValidatorItemsFrom(v.Items)
+		validators = append(validators, validator)
+	}
+	if len(validators) > 0 {
+		ret.Validators = validators
+	}
+	return ret
+}
+
 func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state CustomFieldResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -293,7 +545,7 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 		Options:           options,
 		FavouriteOptions:  &favouriteOptions,
 		RequiredByDefault: state.RequiredByDefault.ValueBool(),
-		Archived:          false,
+		Archived:          state.Archived.ValueBool(),
 		DefaultSortOrder:  state.DefaultSortOrder.ValueInt32Pointer(),
 		Attribute: piano_id.CustomFieldAttribute{
 			DefaultValue:         state.DefaultValue.ValueStringPointer(),
@@ -304,7 +556,7 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 			PreSelectCountryByIp: state.PreSelectCountryById.ValueBoolPointer(),
 		},
 		Validators: validators,
-		Tooltip:    &piano_id.Tooltip{},
+		Tooltip:    tooltipFromState(state),
 	}
 	tflog.Info(ctx, fmt.Sprintf("creating custom_field: %s of type %s", state.FieldName.ValueString(), state.DataType.ValueString()))
 	response, err := r.client.PublisherCustomFieldPost(ctx, []piano_id.CustomFieldDefinition{
@@ -322,73 +574,12 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	var data piano_id.CustomFieldDefinition
-	if result.JSON200 != nil {
-		if len(*result.JSON200) > 0 {
-			data = (*result.JSON200)[0]
-		} else {
-			resp.Diagnostics.AddError("Invalid State", "Piano ID API returned empty response for non empty request")
-			return
-		}
-	} else {
-		messages := []string{}
-		for _, message := range result.JSONDefault.ErrorCodeList {
-			messages = append(messages, message.Message)
-		}
-		tflog.Error(ctx, "errors: ["+strings.Join(messages, ",")+"]")
-		resp.Diagnostics.AddError("Status Error", fmt.Sprintf("Unable to create custom field due to %s", "["+strings.Join(messages, ",")+"]"))
+	data := customFieldDefinitionFrom(result, "create", &resp.Diagnostics)
+	if data == nil {
 		return
 	}
 
-	state.FieldName = types.StringValue(data.FieldName)
-	state.Title = types.StringValue(data.Title)
-	state.Comment = types.StringPointerValue(data.Comment)
-	state.Editable = types.BoolValue(data.Editable)
-	state.DataType = types.StringValue(string(data.DataType))
-	if state.Options != nil {
-		optionsFromResponse := []types.String{}
-		for _, option := range data.Options {
-			optionsFromResponse = append(optionsFromResponse, types.StringValue(option))
-		}
-		state.Options = &optionsFromResponse
-	}
-	state.RequiredByDefault = types.BoolValue(data.RequiredByDefault)
-	state.DefaultValue = types.StringPointerValue(data.Attribute.DefaultValue)
-	state.Multiline = types.BoolPointerValue(data.Attribute.Multiline)
-	state.Archived = types.BoolValue(data.Archived)
-	for _, validator := range data.Validators {
-		if string(validator.Type) == "STR_LENGTH" && state.LengthValidator != nil {
-			state.LengthValidator.MinLength = types.Int32PointerValue(validator.Params.MinLength)
-			state.LengthValidator.MaxLength = types.Int32PointerValue(validator.Params.MaxLength)
-			state.LengthValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "REGEXP" && state.RegexValidator != nil {
-			state.RegexValidator.Pattern = types.StringPointerValue(validator.Params.Regexp)
-			state.RegexValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "EMAIL" && state.EmailValidator != nil {
-			state.EmailValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "WHITELIST" && state.AllowListValidator != nil {
-			items := []types.String{}
-			if validator.Params.Whitelist != nil {
-				for _, item := range *validator.Params.Whitelist {
-					items = append(items, types.StringValue(item))
-				}
-			}
-			state.AllowListValidator.Items = items
-			state.AllowListValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "BLACKLIST" && state.DenyListValidator != nil {
-			items := []types.String{}
-			if validator.Params.Blacklist != nil {
-				for _, item := range *validator.Params.Blacklist {
-					items = append(items, types.StringValue(item))
-				}
-			}
-
-			state.DenyListValidator.Items = items
-			state.DenyListValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else {
-			// exaustiveness
-		}
-	}
+	resp.Diagnostics.Append(reconcileCustomFieldState(&state, *data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -417,7 +608,7 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 			Options:           options,
 			FavouriteOptions:  &favouriteOptions,
 			RequiredByDefault: state.RequiredByDefault.ValueBool(),
-			Archived:          false,
+			Archived:          state.Archived.ValueBool(),
 			DefaultSortOrder:  state.DefaultSortOrder.ValueInt32Pointer(),
 			Attribute: piano_id.CustomFieldAttribute{
 				DefaultValue:         state.DefaultValue.ValueStringPointer(),
@@ -428,7 +619,7 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 				PreSelectCountryByIp: state.PreSelectCountryById.ValueBoolPointer(),
 			},
 			Validators: validators,
-			Tooltip:    &piano_id.Tooltip{},
+			Tooltip:    tooltipFromState(state),
 		},
 	})
 	if err != nil {
@@ -440,63 +631,11 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to parse response as ParsePublisherCustomFieldPostResponse, got error: %s", err))
 		return
 	}
-	var data piano_id.CustomFieldDefinition
-	if len(*result.JSON200) > 0 {
-		data = (*result.JSON200)[0]
-	} else {
-		resp.Diagnostics.AddError("Invalid State", "Piano ID API returned empty response for non empty request")
+	data := customFieldDefinitionFrom(result, "update", &resp.Diagnostics)
+	if data == nil {
 		return
 	}
-	state.FieldName = types.StringValue(data.FieldName)
-	state.Title = types.StringValue(data.Title)
-	state.Comment = types.StringPointerValue(data.Comment)
-	state.Editable = types.BoolValue(data.Editable)
-	state.DataType = types.StringValue(string(data.DataType))
-	if state.Options != nil {
-		optionsFromResponse := []types.String{}
-		for _, option := range data.Options {
-			optionsFromResponse = append(optionsFromResponse, types.StringValue(option))
-		}
-		state.Options = &optionsFromResponse
-	}
-	state.RequiredByDefault = types.BoolValue(data.RequiredByDefault)
-	state.Archived = types.BoolValue(data.Archived)
-	state.DefaultValue = types.StringPointerValue(data.Attribute.DefaultValue)
-	state.Multiline = types.BoolPointerValue(data.Attribute.Multiline)
-	for _, validator := range data.Validators {
-		if string(validator.Type) == "STR_LENGTH" && state.LengthValidator != nil {
-			state.LengthValidator.MinLength = types.Int32PointerValue(validator.Params.MinLength)
-			state.LengthValidator.MaxLength = types.Int32PointerValue(validator.Params.MaxLength)
-			state.LengthValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "REGEXP" && state.RegexValidator != nil {
-			state.RegexValidator.Pattern = types.StringPointerValue(validator.Params.Regexp)
-			state.RegexValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "EMAIL" && state.EmailValidator != nil {
-			state.EmailValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "WHITELIST" && state.AllowListValidator != nil {
-			items := []types.String{}
-			if validator.Params.Whitelist != nil {
-				for _, item := range *validator.Params.Whitelist {
-					items = append(items, types.StringValue(item))
-				}
-			}
-
-			state.AllowListValidator.Items = items
-			state.AllowListValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else if string(validator.Type) == "BLACKLIST" && state.DenyListValidator != nil {
-			items := []types.String{}
-			if validator.Params.Blacklist != nil {
-				for _, item := range *validator.Params.Blacklist {
-					items = append(items, types.StringValue(item))
-				}
-			}
-
-			state.DenyListValidator.Items = items
-			state.DenyListValidator.ErrorMessage = types.StringPointerValue(validator.ReponseErrorMessage)
-		} else {
-			// exaustiveness
-		}
-	}
+	resp.Diagnostics.Append(reconcileCustomFieldState(&state, *data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -514,6 +653,8 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 	validators := validatorsFromState(state)
 	favouriteOptions := favouriteOptionsFromState(state)
 
+	// piano id API cannot delete a custom field, so the definition is sent again with archived=true.
+	// syntax.DeletedResponseFrom does not apply to piano id API responses; archiving a field archived outside terraform succeeds anyway.
 	tflog.Warn(ctx, fmt.Sprintf("(logically) deleting custom field by setting archived=true for %s", state.FieldName.ValueString()))
 	response, err := r.client.PublisherCustomFieldPost(ctx, []piano_id.CustomFieldDefinition{
 		{
@@ -536,7 +677,7 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 				PreSelectCountryByIp: state.PreSelectCountryById.ValueBoolPointer(),
 			},
 			Validators: validators,
-			Tooltip:    &piano_id.Tooltip{},
+			Tooltip:    tooltipFromState(state),
 		},
 	})
 	if err != nil {
@@ -548,11 +689,8 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 		resp.Diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to parse response as ParsePublisherCustomFieldPostResponse, got error: %s", err))
 		return
 	}
-	var data piano_id.CustomFieldDefinition
-	if len(*result.JSON200) > 0 {
-		data = (*result.JSON200)[0]
-	} else {
-		resp.Diagnostics.AddError("Invalid State", "Piano ID API returned empty response for non empty request")
+	data := customFieldDefinitionFrom(result, "delete", &resp.Diagnostics)
+	if data == nil {
 		return
 	}
 	if !data.Archived {
@@ -561,6 +699,82 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// customFieldDefinitionFrom returns the custom field definition in a successful response of /publisher/customField.
+// It returns nil after reporting an error when the response is unsuccessful or empty.
+func customFieldDefinitionFrom(result *piano_id.PublisherCustomFieldPostResponse, operation string, diagnostics *diag.Diagnostics) *piano_id.CustomFieldDefinition {
+	if result.JSON200 == nil {
+		messages := []string{}
+		if result.JSONDefault != nil {
+			for _, message := range result.JSONDefault.ErrorCodeList {
+				messages = append(messages, message.Message)
+			}
+		}
+		diagnostics.AddError("Status Error", fmt.Sprintf("Unable to %s custom field due to %s", operation, "["+strings.Join(messages, ",")+"]"))
+		return nil
+	}
+	if len(*result.JSON200) == 0 {
+		diagnostics.AddError("Invalid State", "Piano ID API returned empty response for non empty request")
+		return nil
+	}
+	return &(*result.JSON200)[0]
+}
+
+// reconcileCustomFieldState updates state with the custom field definition returned from piano id API.
+// Create and Update share this so that a future Read reconciles the same attributes, including editable and required_by_default.
+// It warns about validators whose type is unknown to the provider, as they cannot be kept in state.
+func reconcileCustomFieldState(state *CustomFieldResourceModel, data piano_id.CustomFieldDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+	unsupported := []string{}
+	state.FieldName = types.StringValue(data.FieldName)
+	state.Title = types.StringValue(data.Title)
+	state.Comment = types.StringPointerValue(data.Comment)
+	state.Editable = types.BoolValue(data.Editable)
+	state.DataType = types.StringValue(string(data.DataType))
+	if state.Options != nil {
+		optionsFromResponse := []types.String{}
+		for _, option := range data.Options {
+			optionsFromResponse = append(optionsFromResponse, types.StringValue(option))
+		}
+		state.Options = &optionsFromResponse
+	}
+	state.RequiredByDefault = types.BoolValue(data.RequiredByDefault)
+	state.DefaultValue = types.StringPointerValue(data.Attribute.DefaultValue)
+	state.Multiline = types.BoolPointerValue(data.Attribute.Multiline)
+	state.Archived = types.BoolValue(data.Archived)
+	if !state.TooltipText.IsNull() || !state.TooltipType.IsNull() {
+		tooltip := piano_id.Tooltip{}
+		if data.Tooltip != nil {
+			tooltip = *data.Tooltip
+		}
+		state.TooltipText = types.StringPointerValue(tooltip.Text)
+		state.TooltipType = types.StringPointerValue(tooltip.Type)
+	}
+	if !state.Prechecked.IsNull() {
+		state.Prechecked = types.BoolValue(data.FavouriteOptions != nil && slices.Contains(*data.FavouriteOptions, piano_id.Prechecked))
+	}
+	validators := []CustomFieldValidatorModel{}
+	for _, item := range data.Validators {
+		model, ok := customFieldValidatorModelFrom(item)
+		if !ok {
+			unsupported = append(unsupported, string(item.Type))
+			continue
+		}
+		validators = append(validators, model)
+	}
+	// Validators which are not configured are left untouched as the attribute is not computed.
+	if state.Validators != nil {
+		state.Validators = validators
+	}
+	if len(unsupported) > 0 {
+		diags.AddWarning(
+			"Unsupported Custom Field Validator",
+			fmt.Sprintf("Custom field %s has validators of type %s, which this provider does not support yet. "+
+				"They are not tracked in state and will be removed from the custom field on the next update.", data.FieldName, strings.Join(unsupported, ", ")),
+		)
+	}
+	return diags
+}
+
 func favouriteOptionsFromState(state CustomFieldResourceModel) []piano_id.CustomFieldDefinitionFavouriteOptions {
 	options := []piano_id.CustomFieldDefinitionFavouriteOptions{}
 
@@ -570,60 +784,77 @@ func favouriteOptionsFromState(state CustomFieldResourceModel) []piano_id.Custom
 	return options
 }
 
+// tooltipFromState enables the tooltip only when tooltip_text is set.
+func tooltipFromState(state CustomFieldResourceModel) *piano_id.Tooltip {
+	enabled := !state.TooltipText.IsNull()
+	return &piano_id.Tooltip{
+		Enabled: &enabled,
+		Text:    state.TooltipText.ValueStringPointer(),
+		Type:    state.TooltipType.ValueStringPointer(),
+	}
+}
+
 func validatorsFromState(state CustomFieldResourceModel) []piano_id.Validator {
 	validators := []piano_id.Validator{}
-	if state.LengthValidator != nil {
-		validators = append(validators, piano_id.Validator{
-			Type: "STR_LENGTH",
-			Params: piano_id.ValidatorParameter{
-				MinLength: state.LengthValidator.MinLength.ValueInt32Pointer(),
-				MaxLength: state.LengthValidator.MaxLength.ValueInt32Pointer(),
-			},
-			ErrorMessage: state.LengthValidator.ErrorMessage.ValueStringPointer(),
-		})
-	}
-	if state.RegexValidator != nil {
-		validators = append(validators, piano_id.Validator{
-			Type: "REGEXP",
-			Params: piano_id.ValidatorParameter{
-				Regexp: state.RegexValidator.Pattern.ValueStringPointer(),
-			},
-			ErrorMessage: state.RegexValidator.ErrorMessage.ValueStringPointer(),
-		})
-	}
-	if state.EmailValidator != nil {
-		validators = append(validators, piano_id.Validator{
-			Type:         "EMAIL",
+	for _, item := range state.Validators {
+		validator := piano_id.Validator{
+			Type:         piano_id.ValidatorType(item.Type.ValueString()),
 			Params:       piano_id.ValidatorParameter{},
-			ErrorMessage: state.EmailValidator.ErrorMessage.ValueStringPointer(),
-		})
-	}
-	if state.AllowListValidator != nil {
+			ErrorMessage: item.ErrorMessage.ValueStringPointer(),
+		}
 		list := []string{}
-		for _, item := range state.AllowListValidator.Items {
-			list = append(list, item.ValueString())
+		for _, element := range item.Items {
+			list = append(list, element.ValueString())
 		}
-		validators = append(validators, piano_id.Validator{
-			Type: "WHITELIST",
-			Params: piano_id.ValidatorParameter{
-				Whitelist: &list,
-			},
-			ErrorMessage: state.AllowListValidator.ErrorMessage.ValueStringPointer(),
-		})
+		switch validator.Type {
+		case piano_id.STRLENGTH:
+			validator.Params.MinLength = item.MinLength.ValueInt32Pointer()
+			validator.Params.MaxLength = item.MaxLength.ValueInt32Pointer()
+		case piano_id.REGEXP:
+			validator.Params.Regexp = item.Pattern.ValueStringPointer()
+		case piano_id.WHITELIST:
+			validator.Params.Whitelist = &list
+		case piano_id.BLACKLIST:
+			validator.Params.Blacklist = &list
+		}
+		validators = append(validators, validator)
 	}
+	return validators
+}
 
-	if state.DenyListValidator != nil {
-		list := []string{}
-		for _, item := range state.DenyListValidator.Items {
-			list = append(list, item.ValueString())
+// customFieldValidatorModelFrom converts a validator returned from piano id API into the model.
+// It reports false when the validator type is not supported by this provider.
+func customFieldValidatorModelFrom(data piano_id.Validator) (CustomFieldValidatorModel, bool) {
+	ret := CustomFieldValidatorModel{
+		Type:         types.StringValue(string(data.Type)),
+		MinLength:    types.Int32Null(),
+		MaxLength:    types.Int32Null(),
+		Pattern:      types.StringNull(),
+		ErrorMessage: types.StringPointerValue(data.ReponseErrorMessage),
+	}
+	switch data.Type {
+	case piano_id.STRLENGTH:
+		ret.MinLength = types.Int32PointerValue(data.Params.MinLength)
+		ret.MaxLength = types.Int32PointerValue(data.Params.MaxLength)
+	case piano_id.REGEXP:
+		ret.Pattern = types.StringPointerValue(data.Params.Regexp)
+	case piano_id.EMAIL:
+	case piano_id.WHITELIST:
+		ret.Items = customFieldValidatorItemsFrom(data.Params.Whitelist)
+	case piano_id.BLACKLIST:
+		ret.Items = customFieldValidatorItemsFrom(data.Params.Blacklist)
+	default:
+		return ret, false
+	}
+	return ret, true
+}
+
+func customFieldValidatorItemsFrom(items *[]string) []types.String {
+	ret := []types.String{}
+	if items != nil {
+		for _, item := range *items {
+			ret = append(ret, types.StringValue(item))
 		}
-		validators = append(validators, piano_id.Validator{
-			Type: "BLACKLIST",
-			Params: piano_id.ValidatorParameter{
-				Blacklist: &list,
-			},
-			ErrorMessage: state.DenyListValidator.ErrorMessage.ValueStringPointer(),
-		})
 	}
-	return validators
+	return ret
 }
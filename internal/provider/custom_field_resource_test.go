@@ -0,0 +1,630 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-piano/internal/piano_id"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCustomFieldResourceEditableAndRequiredByDefaultHaveNoDrift(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/publisher/customField" {
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+		var body []piano_id.CustomFieldDefinition
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if len(body) != 1 || body[0].Editable || !body[0].RequiredByDefault {
+			t.Errorf("expected editable=false and required_by_default=true, got %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"field_name":"nickname","title":"Nickname","editable":false,"data_type":"TEXT","options":[],"required_by_default":true,"archived":false,"attribute":{},"validators":[]}]`)
+	}))
+	defer server.Close()
+	client, err := piano_id.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+		Aid:               types.StringValue("example"),
+		FieldName:         types.StringValue("nickname"),
+		Title:             types.StringValue("Nickname"),
+		Editable:          types.BoolValue(false),
+		DataType:          types.StringValue("TEXT"),
+		RequiredByDefault: types.BoolValue(true),
+		Archived:          types.BoolUnknown(),
+	})
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.Equal(createResp.State.Raw) {
+		t.Errorf("expected no drift after refresh, got %s", readResp.State.Raw)
+	}
+	var actual CustomFieldResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &actual)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	if !actual.Editable.Equal(types.BoolValue(false)) || !actual.RequiredByDefault.Equal(types.BoolValue(true)) {
+		t.Errorf("expected editable=false and required_by_default=true, got %s %s", actual.Editable, actual.RequiredByDefault)
+	}
+}
+
+func TestCustomFieldResourceWarnsUnsupportedValidators(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"field_name":"nickname","title":"Nickname","editable":true,"data_type":"TEXT","options":[],"required_by_default":false,"archived":false,"attribute":{},`+
+			`"validators":[{"type":"EMAIL","params":{},"error_message":"invalid email"},{"type":"PHONE_NUMBER","params":{},"error_message":"invalid phone number"}]}]`)
+	}))
+	defer server.Close()
+	client, err := piano_id.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+		Aid:               types.StringValue("example"),
+		FieldName:         types.StringValue("nickname"),
+		Title:             types.StringValue("Nickname"),
+		Editable:          types.BoolValue(true),
+		DataType:          types.StringValue("TEXT"),
+		RequiredByDefault: types.BoolValue(false),
+		Archived:          types.BoolUnknown(),
+		Validators: []CustomFieldValidatorModel{
+			{
+				Type:         types.StringValue("EMAIL"),
+				MinLength:    types.Int32Null(),
+				MaxLength:    types.Int32Null(),
+				Pattern:      types.StringNull(),
+				ErrorMessage: types.StringValue("invalid email"),
+			},
+		},
+	})
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	warnings := createResp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "PHONE_NUMBER") || strings.Contains(warnings[0].Detail(), "EMAIL") {
+		t.Errorf("expected a warning about PHONE_NUMBER validator only, got %v", createResp.Diagnostics)
+	}
+}
+
+func TestCustomFieldResourceMultipleValidatorsOfSameType(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body []piano_id.CustomFieldDefinition
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		validators := body[0].Validators
+		if len(validators) != 3 || validators[0].Type != piano_id.REGEXP || validators[1].Type != piano_id.REGEXP || validators[2].Type != piano_id.WHITELIST {
+			t.Errorf("expected two REGEXP validators and a WHITELIST validator, got %v", validators)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"field_name":"code","title":"Code","editable":true,"data_type":"TEXT","options":[],"required_by_default":false,"archived":false,"attribute":{},"validators":[`+
+			`{"type":"REGEXP","params":{"regexp":"^[A-Z]"},"error_message":"must start with a capital letter"},`+
+			`{"type":"REGEXP","params":{"regexp":"[0-9]$"},"error_message":"must end with a digit"},`+
+			`{"type":"WHITELIST","params":{"whitelist":["A1","B2"]},"error_message":"unknown code"}]}]`)
+	}))
+	defer server.Close()
+	client, err := piano_id.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	validators := []CustomFieldValidatorModel{
+		{Type: types.StringValue("REGEXP"), Pattern: types.StringValue("^[A-Z]"), ErrorMessage: types.StringValue("must start with a capital letter")},
+		{Type: types.StringValue("REGEXP"), Pattern: types.StringValue("[0-9]$"), ErrorMessage: types.StringValue("must end with a digit")},
+		{Type: types.StringValue("WHITELIST"), Pattern: types.StringNull(), Items: []types.String{types.StringValue("A1"), types.StringValue("B2")}, ErrorMessage: types.StringValue("unknown code")},
+	}
+	for i := range validators {
+		validators[i].MinLength = types.Int32Null()
+		validators[i].MaxLength = types.Int32Null()
+	}
+	plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+		Aid:               types.StringValue("example"),
+		FieldName:         types.StringValue("code"),
+		Title:             types.StringValue("Code"),
+		Editable:          types.BoolValue(true),
+		DataType:          types.StringValue("TEXT"),
+		RequiredByDefault: types.BoolValue(false),
+		Archived:          types.BoolUnknown(),
+		Validators:        validators,
+	})
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() || createResp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+	var actual CustomFieldResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &actual)...)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	var planned CustomFieldResourceModel
+	plan.Get(ctx, &planned)
+	if len(actual.Validators) != 3 {
+		t.Fatalf("expected 3 validators, got %v", actual.Validators)
+	}
+	for i, v := range actual.Validators {
+		expected := planned.Validators[i]
+		if !v.Type.Equal(expected.Type) || !v.Pattern.Equal(expected.Pattern) || !v.ErrorMessage.Equal(expected.ErrorMessage) || len(v.Items) != len(expected.Items) {
+			t.Errorf("validators[%d]: expected %v, got %v", i, expected, v)
+		}
+	}
+}
+
+func TestCustomFieldResourceUpgradeStateFromV0(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomFieldResource{}
+	current := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("expected a state upgrader from version 0")
+	}
+
+	// version 0 state with one object per validator type
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"aid": "example",
+			"field_name": "nickname",
+			"title": "Nickname",
+			"editable": true,
+			"data_type": "TEXT",
+			"options": null,
+			"required_by_default": false,
+			"archived": false,
+			"validators": null,
+			"length_validator": {"min_length": 1, "max_length": 20, "error_message": "too long"},
+			"regex_validator": null,
+			"email_validator": null,
+			"allow_list_validator": null,
+			"deny_list_validator": {"items": ["admin"], "error_message": "reserved"}
+		}`)},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: newTestState(t, current.Schema, nil),
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var actual CustomFieldResourceModel
+	if diags := resp.State.Get(ctx, &actual); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if actual.FieldName.ValueString() != "nickname" || actual.Options != nil {
+		t.Errorf("expected attributes to be kept, got %s %v", actual.FieldName, actual.Options)
+	}
+	if len(actual.Validators) != 2 {
+		t.Fatalf("expected 2 validators, got %v", actual.Validators)
+	}
+	length, deny := actual.Validators[0], actual.Validators[1]
+	if length.Type.ValueString() != "STR_LENGTH" || length.MinLength.ValueInt32() != 1 || length.MaxLength.ValueInt32() != 20 || length.ErrorMessage.ValueString() != "too long" {
+		t.Errorf("unexpected length validator: %v", length)
+	}
+	if deny.Type.ValueString() != "BLACKLIST" || len(deny.Items) != 1 || deny.Items[0].ValueString() != "admin" || !deny.Pattern.IsNull() {
+		t.Errorf("unexpected deny list validator: %v", deny)
+	}
+}
+
+func TestCustomFieldResourceValidateConfigValidators(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomFieldResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	validatorType := objectType.AttributeTypes["validators"].(tftypes.List).ElementType.(tftypes.Object)
+	newValidator := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range validatorType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		for name, value := range values {
+			attributes[name] = value
+		}
+		return tftypes.NewValue(validatorType, attributes)
+	}
+	items := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "admin")})
+
+	cases := []struct {
+		name          string
+		validator     tftypes.Value
+		expectedError bool
+	}{
+		{name: "regexp with pattern", validator: newValidator(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "REGEXP"), "pattern": tftypes.NewValue(tftypes.String, "^a")})},
+		{name: "regexp without pattern", validator: newValidator(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "REGEXP")}), expectedError: true},
+		{name: "blacklist with items", validator: newValidator(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "BLACKLIST"), "items": items})},
+		{name: "whitelist without items", validator: newValidator(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "WHITELIST")}), expectedError: true},
+		{name: "email with items", validator: newValidator(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "EMAIL"), "items": items}), expectedError: true},
+		{name: "length with unknown min_length", validator: newValidator(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "STR_LENGTH"), "min_length": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)})},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["validators"] = tftypes.NewValue(tftypes.List{ElementType: validatorType}, []tftypes.Value{c.validator})
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCustomFieldResourceValidateConfigDataTypeParams(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomFieldResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	options := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "red")})
+	dateFormat := tftypes.NewValue(tftypes.String, "yyyy/mm/dd")
+	multiline := tftypes.NewValue(tftypes.Bool, true)
+
+	cases := []struct {
+		name          string
+		dataType      tftypes.Value
+		values        map[string]tftypes.Value
+		expectedError string
+	}{
+		{name: "date_format for ISO_DATE", dataType: tftypes.NewValue(tftypes.String, "ISO_DATE"), values: map[string]tftypes.Value{"date_format": dateFormat}},
+		{name: "date_format for TEXT", dataType: tftypes.NewValue(tftypes.String, "TEXT"), values: map[string]tftypes.Value{"date_format": dateFormat}, expectedError: "date_format is only valid when data_type is ISO_DATE, got TEXT."},
+		{name: "options for SINGLE_SELECT_LIST", dataType: tftypes.NewValue(tftypes.String, "SINGLE_SELECT_LIST"), values: map[string]tftypes.Value{"options": options}},
+		{name: "options for MULTI_SELECT_LIST", dataType: tftypes.NewValue(tftypes.String, "MULTI_SELECT_LIST"), values: map[string]tftypes.Value{"options": options}},
+		{name: "options for NUMBER", dataType: tftypes.NewValue(tftypes.String, "NUMBER"), values: map[string]tftypes.Value{"options": options}, expectedError: "options is only valid when data_type is SINGLE_SELECT_LIST or MULTI_SELECT_LIST, got NUMBER."},
+		{name: "multiline for TEXT", dataType: tftypes.NewValue(tftypes.String, "TEXT"), values: map[string]tftypes.Value{"multiline": multiline}},
+		{name: "multiline for BOOLEAN", dataType: tftypes.NewValue(tftypes.String, "BOOLEAN"), values: map[string]tftypes.Value{"multiline": multiline}, expectedError: "multiline is only valid when data_type is TEXT, got BOOLEAN."},
+		{name: "unknown options", dataType: tftypes.NewValue(tftypes.String, "NUMBER"), values: map[string]tftypes.Value{"options": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)}, expectedError: "options is only valid when data_type is SINGLE_SELECT_LIST or MULTI_SELECT_LIST, got NUMBER."},
+		{name: "unknown data_type", dataType: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), values: map[string]tftypes.Value{"multiline": multiline}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["data_type"] = c.dataType
+			for name, value := range c.values {
+				values[name] = value
+			}
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, &resp)
+			if c.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Detail() != c.expectedError {
+				t.Errorf("expected error %q, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCustomFieldResourceValidateConfigDefaultValue(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomFieldResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	str := func(value string) tftypes.Value {
+		return tftypes.NewValue(tftypes.String, value)
+	}
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	cases := []struct {
+		name          string
+		dataType      tftypes.Value
+		defaultValue  tftypes.Value
+		dateFormat    tftypes.Value
+		expectedError bool
+	}{
+		{name: "integer for NUMBER", dataType: str("NUMBER"), defaultValue: str("42"), dateFormat: null},
+		{name: "text for NUMBER", dataType: str("NUMBER"), defaultValue: str("abc"), dateFormat: null, expectedError: true},
+		{name: "decimal for NUMBER", dataType: str("NUMBER"), defaultValue: str("1.5"), dateFormat: null, expectedError: true},
+		{name: "true for BOOLEAN", dataType: str("BOOLEAN"), defaultValue: str("true"), dateFormat: null},
+		{name: "yes for BOOLEAN", dataType: str("BOOLEAN"), defaultValue: str("yes"), dateFormat: null, expectedError: true},
+		{name: "date in date_format for ISO_DATE", dataType: str("ISO_DATE"), defaultValue: str("31.12.2024"), dateFormat: str("dd.mm.yyyy")},
+		{name: "date in another format for ISO_DATE", dataType: str("ISO_DATE"), defaultValue: str("2024/12/31"), dateFormat: str("dd.mm.yyyy"), expectedError: true},
+		{name: "date without date_format for ISO_DATE", dataType: str("ISO_DATE"), defaultValue: str("2024/12/31"), dateFormat: null},
+		{name: "any text for TEXT", dataType: str("TEXT"), defaultValue: str("abc"), dateFormat: null},
+		{name: "unknown default_value", dataType: str("NUMBER"), defaultValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), dateFormat: null},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["data_type"] = c.dataType
+			values["default_value"] = c.defaultValue
+			values["date_format"] = c.dateFormat
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCustomFieldResourcePrecheckedRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body []piano_id.CustomFieldDefinition
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body[0].FavouriteOptions == nil || len(*body[0].FavouriteOptions) != 1 || (*body[0].FavouriteOptions)[0] != piano_id.Prechecked {
+			t.Errorf("expected favourite_options to contain prechecked, got %v", body[0].FavouriteOptions)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"field_name":"newsletter","title":"Newsletter","editable":true,"data_type":"BOOLEAN","options":[],"favourite_options":["prechecked"],"required_by_default":false,"archived":false,"attribute":{},"validators":[]}]`)
+	}))
+	defer server.Close()
+	client, err := piano_id.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+		Aid:               types.StringValue("example"),
+		FieldName:         types.StringValue("newsletter"),
+		Title:             types.StringValue("Newsletter"),
+		Editable:          types.BoolValue(true),
+		DataType:          types.StringValue("BOOLEAN"),
+		RequiredByDefault: types.BoolValue(false),
+		Archived:          types.BoolUnknown(),
+		Prechecked:        types.BoolValue(true),
+	})
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	var actual CustomFieldResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &actual)...)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if !actual.Prechecked.Equal(types.BoolValue(true)) {
+		t.Errorf("expected prechecked to be true, got %s", actual.Prechecked)
+	}
+}
+
+func TestCustomFieldResourceTooltipRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body []piano_id.CustomFieldDefinition
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		tooltip := body[0].Tooltip
+		if tooltip == nil || tooltip.Enabled == nil || !*tooltip.Enabled || tooltip.Text == nil || *tooltip.Text != "Shown on your profile" {
+			t.Errorf("expected an enabled tooltip, got %v", tooltip)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"field_name":"nickname","title":"Nickname","editable":true,"data_type":"TEXT","options":[],"required_by_default":false,"archived":false,"attribute":{},"validators":[],`+
+			`"tooltip":{"enabled":true,"text":"Shown on your profile","type":"info"}}]`)
+	}))
+	defer server.Close()
+	client, err := piano_id.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+		Aid:               types.StringValue("example"),
+		FieldName:         types.StringValue("nickname"),
+		Title:             types.StringValue("Nickname"),
+		Editable:          types.BoolValue(true),
+		DataType:          types.StringValue("TEXT"),
+		RequiredByDefault: types.BoolValue(false),
+		Archived:          types.BoolUnknown(),
+		TooltipText:       types.StringValue("Shown on your profile"),
+		TooltipType:       types.StringValue("info"),
+	})
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	var actual CustomFieldResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &actual)...)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if !actual.TooltipText.Equal(types.StringValue("Shown on your profile")) || !actual.TooltipType.Equal(types.StringValue("info")) {
+		t.Errorf("expected tooltip to round-trip, got %s %s", actual.TooltipText, actual.TooltipType)
+	}
+}
+
+func TestCustomFieldResourceUpdateTogglesArchived(t *testing.T) {
+	ctx := context.Background()
+	requested := []bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body []piano_id.CustomFieldDefinition
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if len(body) != 1 {
+			t.Fatalf("expected a custom field, got %v", body)
+		}
+		requested = append(requested, body[0].Archived)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"field_name":"nickname","title":"Nickname","editable":true,"data_type":"TEXT","options":[],"required_by_default":false,"archived":%t,"attribute":{},"validators":[]}]`, body[0].Archived)
+	}))
+	defer server.Close()
+	client, err := piano_id.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := newTestState(t, schemaResp.Schema, nil)
+	for _, archived := range []bool{true, false} {
+		plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+			Aid:               types.StringValue("example"),
+			FieldName:         types.StringValue("nickname"),
+			Title:             types.StringValue("Nickname"),
+			Editable:          types.BoolValue(true),
+			DataType:          types.StringValue("TEXT"),
+			RequiredByDefault: types.BoolValue(false),
+			Archived:          types.BoolValue(archived),
+		})
+		updateResp := resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+		}
+		var actual CustomFieldResourceModel
+		updateResp.Diagnostics.Append(updateResp.State.Get(ctx, &actual)...)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+		}
+		if !actual.Archived.Equal(types.BoolValue(archived)) {
+			t.Errorf("expected archived=%t, got %s", archived, actual.Archived)
+		}
+		state = updateResp.State
+	}
+	if len(requested) != 2 || !requested[0] || requested[1] {
+		t.Errorf("expected archived=true then archived=false to be sent, got %v", requested)
+	}
+}
+
+func TestCustomFieldResourceDelete(t *testing.T) {
+	cases := []struct {
+		name          string
+		status        int
+		response      string
+		expectedError string
+	}{
+		{
+			name:     "archived",
+			status:   http.StatusOK,
+			response: `[{"field_name":"nickname","title":"Nickname","editable":true,"data_type":"TEXT","options":[],"required_by_default":false,"archived":true,"attribute":{},"validators":[]}]`,
+		},
+		{
+			name:          "not archived",
+			status:        http.StatusOK,
+			response:      `[{"field_name":"nickname","title":"Nickname","editable":true,"data_type":"TEXT","options":[],"required_by_default":false,"archived":false,"attribute":{},"validators":[]}]`,
+			expectedError: "Invalid State",
+		},
+		{
+			name:          "error",
+			status:        http.StatusBadRequest,
+			response:      `{"error_code_list":[{"message":"Invalid field"}]}`,
+			expectedError: "Status Error",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				var body []piano_id.CustomFieldDefinition
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+				if len(body) != 1 || !body[0].Archived {
+					t.Errorf("expected the custom field to be archived, got %v", body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_id.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &CustomFieldResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, &CustomFieldResourceModel{
+				Aid:               types.StringValue("example"),
+				FieldName:         types.StringValue("nickname"),
+				Title:             types.StringValue("Nickname"),
+				Editable:          types.BoolValue(true),
+				DataType:          types.StringValue("TEXT"),
+				RequiredByDefault: types.BoolValue(false),
+				Archived:          types.BoolValue(false),
+			})
+
+			resp := resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+			if c.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != c.expectedError {
+				t.Errorf("expected %s, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
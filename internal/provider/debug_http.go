@@ -0,0 +1,83 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "***"
+
+// sensitiveHeaders and sensitiveQueryParams are redacted from debug logs unless insecure_log_sensitive is enabled.
+var (
+	sensitiveHeaders     = []string{"API_TOKEN", "Authorization"}
+	sensitiveQueryParams = []string{"api_token"}
+)
+
+// debugHttpTransport logs HTTP requests and responses exchanged with piano.io API when debug_http is enabled.
+type debugHttpTransport struct {
+	transport            http.RoundTripper
+	insecureLogSensitive bool
+}
+
+func (t *debugHttpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	dump, err := dumpRequestForLog(req, t.insecureLogSensitive)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to dump request for debug log: %s", err))
+	} else {
+		tflog.Debug(ctx, dump)
+	}
+	response, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return response, err
+	}
+	body, err := httputil.DumpResponse(response, true)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to dump response for debug log: %s", err))
+	} else {
+		tflog.Debug(ctx, string(body))
+	}
+	return response, nil
+}
+
+// dumpRequestForLog renders req in wire format with sensitive headers and query parameters redacted.
+// Sensitive values are kept as is only when insecureLogSensitive is true.
+func dumpRequestForLog(req *http.Request, insecureLogSensitive bool) (string, error) {
+	if insecureLogSensitive {
+		dump, err := httputil.DumpRequestOut(req, true)
+		return string(dump), err
+	}
+	cloned := req.Clone(req.Context())
+	for _, header := range sensitiveHeaders {
+		if cloned.Header.Get(header) != "" {
+			cloned.Header.Set(header, redacted)
+		}
+	}
+	query := cloned.URL.Query()
+	for _, param := range sensitiveQueryParams {
+		if query.Has(param) {
+			query.Set(param, redacted)
+		}
+	}
+	cloned.URL.RawQuery = query.Encode()
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		cloned.Body = body
+	} else {
+		cloned.Body = nil
+	}
+	dump, err := httputil.DumpRequestOut(cloned, cloned.Body != nil)
+	if err != nil {
+		return "", err
+	}
+	return string(dump), nil
+}
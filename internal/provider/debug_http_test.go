@@ -0,0 +1,49 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDumpRequestForLog(t *testing.T) {
+	const token = "secret-api-token"
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest("POST", "https://sandbox.piano.io/id/api/v1/publisher/customField?api_token="+token+"&aid=example", strings.NewReader("name=example"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Add("API_TOKEN", token)
+		return req
+	}
+
+	t.Run("redacts sensitive values by default", func(t *testing.T) {
+		req := newRequest()
+		dump, err := dumpRequestForLog(req, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(dump, token) {
+			t.Errorf("expected token to be redacted, got %s", dump)
+		}
+		if !strings.Contains(dump, "aid=example") || !strings.Contains(dump, "name=example") {
+			t.Errorf("expected non-sensitive values to be logged, got %s", dump)
+		}
+		if req.Header.Get("API_TOKEN") != token || req.URL.Query().Get("api_token") != token {
+			t.Errorf("expected original request to be kept as is")
+		}
+	})
+
+	t.Run("keeps sensitive values when insecure_log_sensitive is set", func(t *testing.T) {
+		dump, err := dumpRequestForLog(newRequest(), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Count(dump, token) != 2 {
+			t.Errorf("expected token to be logged as is, got %s", dump)
+		}
+	})
+}
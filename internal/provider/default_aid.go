@@ -0,0 +1,91 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aidPattern is the format of piano.io application IDs.
+var aidPattern = regexp.MustCompile(`^[A-Za-z0-9]{10}$`)
+
+const aidPatternDescription = "must be a piano.io application ID of 10 alphanumeric characters"
+
+// aidValidators validate aid attributes of resources and data sources so that a malformed aid fails at plan time
+// instead of as an opaque error from piano.io API.
+func aidValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(aidPattern, aidPatternDescription),
+	}
+}
+
+// defaultAidAttribute is the aid attribute shared by resources.
+// When aid is not configured, planDefaultAid fills it with app_id of the provider.
+func defaultAidAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The application ID. Defaults to `app_id` of the provider.",
+		Validators:          aidValidators(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// planDefaultAid plans aid as defaultAid when aid is not configured.
+// defaultAid is null when the provider has not been configured and unknown when app_id is not known yet; aid is left unknown in both cases.
+// As an object cannot move to another application, the resource is replaced when the default changes.
+func planDefaultAid(ctx context.Context, defaultAid types.String, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var aid types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("aid"), &aid)...)
+	if resp.Diagnostics.HasError() || !aid.IsNull() || defaultAid.IsNull() {
+		return
+	}
+	if defaultAid.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("aid"), types.StringUnknown())...)
+		return
+	}
+	if defaultAid.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("aid"),
+			"Missing Application ID",
+			"aid is not set and the provider has no app_id. Set either aid on the resource or app_id on the provider.",
+		)
+		return
+	}
+	// app_id from PIANO_APP_ID environment variable does not go through schema validators.
+	if !aidPattern.MatchString(defaultAid.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("aid"),
+			"Invalid Application ID",
+			fmt.Sprintf("aid is not set and app_id of the provider %s, got: %s", aidPatternDescription, defaultAid.ValueString()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("aid"), defaultAid)...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var prior types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("aid"), &prior)...)
+	if !prior.IsNull() && !prior.Equal(defaultAid) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("aid"))
+	}
+}
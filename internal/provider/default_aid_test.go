@@ -0,0 +1,109 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPlanDefaultAid(t *testing.T) {
+	ctx := context.Background()
+	r := &ContractDomainResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	newValue := func(aid tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"aid":                   aid,
+			"contract_domain_id":    tftypes.NewValue(tftypes.String, "CDXXXXXX"),
+			"contract_id":           tftypes.NewValue(tftypes.String, "TCXXXXXX"),
+			"contract_domain_value": tftypes.NewValue(tftypes.String, "example.com"),
+		})
+	}
+	unset := tftypes.NewValue(tftypes.String, nil)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	cases := []struct {
+		name            string
+		defaultAid      types.String
+		config          tftypes.Value
+		plan            tftypes.Value
+		state           tftypes.Value
+		expected        types.String
+		expectedError   bool
+		requiresReplace bool
+	}{
+		{name: "falls back to app_id", defaultAid: types.StringValue("DEFAULTAID"), config: unset, plan: unknown, state: tftypes.Value{}, expected: types.StringValue("DEFAULTAID")},
+		{name: "prefers configured aid", defaultAid: types.StringValue("DEFAULTAID"), config: tftypes.NewValue(tftypes.String, "example"), plan: tftypes.NewValue(tftypes.String, "example"), state: tftypes.Value{}, expected: types.StringValue("example")},
+		{name: "app_id is not known yet", defaultAid: types.StringUnknown(), config: unset, plan: unknown, state: tftypes.Value{}, expected: types.StringUnknown()},
+		{name: "malformed app_id", defaultAid: types.StringValue("example"), config: unset, plan: unknown, state: tftypes.Value{}, expectedError: true},
+		{name: "neither aid nor app_id", defaultAid: types.StringValue(""), config: unset, plan: unknown, state: tftypes.Value{}, expectedError: true},
+		{name: "app_id is unchanged", defaultAid: types.StringValue("DEFAULTAID"), config: unset, plan: tftypes.NewValue(tftypes.String, "DEFAULTAID"), state: tftypes.NewValue(tftypes.String, "DEFAULTAID"), expected: types.StringValue("DEFAULTAID")},
+		{name: "app_id is changed", defaultAid: types.StringValue("ANOTHERAID"), config: unset, plan: tftypes.NewValue(tftypes.String, "DEFAULTAID"), state: tftypes.NewValue(tftypes.String, "DEFAULTAID"), expected: types.StringValue("ANOTHERAID"), requiresReplace: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := tftypes.NewValue(objectType, nil)
+			if c.state.Type() != nil {
+				state = newValue(c.state)
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newValue(c.config)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(c.plan)},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			planDefaultAid(ctx, c.defaultAid, req, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Fatalf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if c.expectedError {
+				return
+			}
+			var actual types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("aid"), &actual)...)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected aid %s, got %s", c.expected, actual)
+			}
+			if (len(resp.RequiresReplace) > 0) != c.requiresReplace {
+				t.Errorf("expected requires replace: %t, got %v", c.requiresReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestAidValidators(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		aid           types.String
+		expectedError bool
+	}{
+		{aid: types.StringValue("AIDXXXXXXX")},
+		{aid: types.StringValue("1234567890")},
+		{aid: types.StringNull()},
+		{aid: types.StringUnknown()},
+		{aid: types.StringValue("example"), expectedError: true},
+		{aid: types.StringValue("AIDXXXXXXXX"), expectedError: true},
+		{aid: types.StringValue("AIDXXXX-XX"), expectedError: true},
+	}
+	for _, c := range cases {
+		t.Run(c.aid.String(), func(t *testing.T) {
+			resp := validator.StringResponse{}
+			for _, v := range aidValidators() {
+				v.ValidateString(ctx, validator.StringRequest{Path: path.Root("aid"), ConfigValue: c.aid}, &resp)
+			}
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
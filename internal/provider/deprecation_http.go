@@ -0,0 +1,55 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// deprecationHttpTransport warns once per endpoint when piano.io API marks it deprecated
+// with Deprecation or Sunset response headers.
+type deprecationHttpTransport struct {
+	transport http.RoundTripper
+	// warned holds endpoints already reported, keyed by method and path
+	warned sync.Map
+}
+
+func (t *deprecationHttpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	response, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return response, err
+	}
+	message, deprecated := deprecationMessageFrom(response)
+	if !deprecated {
+		return response, nil
+	}
+	endpoint := fmt.Sprintf("%s %s", req.Method, req.URL.Path)
+	if _, loaded := t.warned.LoadOrStore(endpoint, struct{}{}); !loaded {
+		tflog.Warn(req.Context(), fmt.Sprintf("piano.io API endpoint %s is deprecated: %s", endpoint, message), map[string]any{
+			"endpoint": endpoint,
+		})
+	}
+	return response, nil
+}
+
+// deprecationMessageFrom summarizes Deprecation and Sunset headers of response.
+// It reports false when neither header is present.
+func deprecationMessageFrom(response *http.Response) (string, bool) {
+	details := []string{}
+	if deprecation := response.Header.Get("Deprecation"); deprecation != "" {
+		details = append(details, fmt.Sprintf("Deprecation: %s", deprecation))
+	}
+	if sunset := response.Header.Get("Sunset"); sunset != "" {
+		details = append(details, fmt.Sprintf("Sunset: %s", sunset))
+	}
+	if len(details) == 0 {
+		return "", false
+	}
+	return strings.Join(details, ", "), true
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestDeprecationHttpTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/publisher/deprecated" {
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Sunset", "Wed, 31 Dec 2025 23:59:59 GMT")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := &http.Client{Transport: &deprecationHttpTransport{transport: http.DefaultTransport}}
+	for _, path := range []string{"/publisher/deprecated", "/publisher/deprecated", "/publisher/current"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected a warning only once for the deprecated endpoint, got %v", entries)
+	}
+	if entries[0]["@level"] != "warn" || entries[0]["endpoint"] != "GET /publisher/deprecated" {
+		t.Errorf("unexpected log entry: %v", entries[0])
+	}
+	expected := "piano.io API endpoint GET /publisher/deprecated is deprecated: Deprecation: true, Sunset: Wed, 31 Dec 2025 23:59:59 GMT"
+	if entries[0]["@message"] != expected {
+		t.Errorf("expected %q, got %q", expected, entries[0]["@message"])
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ExternalAPIDataSource{}
+	_ datasource.DataSourceWithConfigure = &ExternalAPIDataSource{}
+)
+
+func NewExternalAPIDataSource() datasource.DataSource {
+	return &ExternalAPIDataSource{}
+}
+
+// ExternalAPIDataSource defines the data source implementation.
+type ExternalAPIDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+// ExternalAPIDataSourceModel describes the data source data model.
+type ExternalAPIDataSourceModel struct {
+	Aid                   types.String                      `tfsdk:"aid"`                 // The application ID
+	ExternalApiId         types.String                      `tfsdk:"external_api_id"`     // The ID of the external API configuration
+	ExternalApiName       types.String                      `tfsdk:"external_api_name"`   // The name of the external API configuration
+	ExternalApiSource     types.Int32                       `tfsdk:"external_api_source"` // The source of the external API configuration
+	ExternalApiFormFields []ExternalAPIFieldDataSourceModel `tfsdk:"external_api_form_fields"`
+}
+
+func (*ExternalAPIDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_api"
+}
+
+func (*ExternalAPIDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "External API data source. External API configuration is used by external terms to verify access with an external service. " +
+			"As piano.io publisher API does not provide an endpoint to get an external API configuration, " +
+			"this data source looks up the configuration from the external terms of the application. " +
+			"Therefore, the configuration must be used by at least one external term.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				MarkdownDescription: "The application ID",
+				Required:            true,
+				Validators:          aidValidators(),
+			},
+			"external_api_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the external API configuration",
+				Required:            true,
+			},
+			"external_api_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the external API configuration",
+				Computed:            true,
+			},
+			"external_api_source": schema.Int32Attribute{
+				MarkdownDescription: "The source of the external API configuration",
+				Computed:            true,
+			},
+			"external_api_form_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The form fields of the external API configuration",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the field to be used to submit to the external system",
+						},
+						"field_title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title of the field to be displayed to the user",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The field description, some information about what information should be entered",
+						},
+						"mandatory": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the field is required",
+						},
+						"hidden": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the field will be submitted hiddenly from the user, default value is required",
+						},
+						"default_value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Default value for the field. It will be pre-entered on the form",
+						},
+						"order": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "Field order in the list",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Field type",
+						},
+						"editable": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the object is editable",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ExternalAPIDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.publisherClient
+}
+
+func (d *ExternalAPIDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ExternalAPIDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	termType := string(piano_publisher.ExternalTermTypeExternal)
+	terms, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.Term, int, error) {
+		params := piano_publisher.GetPublisherTermListParams{
+			Aid:    state.Aid.ValueString(),
+			Type:   &termType,
+			Offset: int32(offset),
+			Limit:  int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching external terms in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := d.client.GetPublisherTermList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch terms, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.TermArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.Terms, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	for _, term := range terms {
+		if term.ExternalApiId == nil || *term.ExternalApiId != state.ExternalApiId.ValueString() {
+			continue
+		}
+		state.ExternalApiName = types.StringPointerValue(term.ExternalApiName)
+		state.ExternalApiSource = types.Int32Null()
+		if term.ExternalApiSource != nil {
+			state.ExternalApiSource = types.Int32Value(int32(*term.ExternalApiSource))
+		}
+		externalApiFormFieldsElements := []ExternalAPIFieldDataSourceModel{}
+		if term.ExternalApiFormFields != nil {
+			for _, element := range sortedExternalAPIFields(*term.ExternalApiFormFields) {
+				externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldDataSourceModelFrom(element))
+			}
+		}
+		state.ExternalApiFormFields = externalApiFormFieldsElements
+		tflog.Trace(ctx, "read an external api data source")
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("external_api_id"),
+		"External API Not Found",
+		fmt.Sprintf("No external term in %s uses the external API configuration %s.", state.Aid.ValueString(), state.ExternalApiId.ValueString()),
+	)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExternalAPIDataSourceRead(t *testing.T) {
+	// 120 external terms over two pages, where only the term on the second page uses EXTAPI1.
+	const total = 120
+	cases := []struct {
+		name          string
+		externalApiId string
+		expectedError string
+	}{
+		{name: "found on the second page", externalApiId: "EXTAPI1"},
+		{name: "not used by any term", externalApiId: "EXTAPI2", expectedError: "External API Not Found"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/term/list" {
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+				query := req.URL.Query()
+				if query.Get("aid") != "example" || query.Get("type") != "external" {
+					t.Errorf("expected aid example and type external, got %s", req.URL.RawQuery)
+				}
+				offset, _ := strconv.Atoi(query.Get("offset"))
+				limit, _ := strconv.Atoi(query.Get("limit"))
+				terms := []map[string]any{}
+				for i := offset; i < min(offset+limit, total); i++ {
+					term := map[string]any{"aid": "example", "term_id": fmt.Sprintf("TM%d", i), "type": "external", "external_api_id": "EXTAPI0"}
+					if i == 110 {
+						term["external_api_id"] = "EXTAPI1"
+						term["external_api_name"] = "Subscriber DB"
+						term["external_api_source"] = 4
+						term["external_api_form_fields"] = []map[string]any{
+							{"field_name": "zip", "field_title": "Zip", "description": "", "mandatory": false, "hidden": false, "default_value": nil, "order": 2, "type": "input", "editable": "true"},
+							{"field_name": "member_id", "field_title": "Member ID", "description": "Your member ID", "mandatory": true, "hidden": false, "default_value": "M-", "order": 1, "type": "input", "editable": "true"},
+						}
+					}
+					terms = append(terms, term)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "terms": terms, "total": total}); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &ExternalAPIDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &ExternalAPIDataSourceModel{
+				Aid:               types.StringValue("example"),
+				ExternalApiId:     types.StringValue(c.externalApiId),
+				ExternalApiName:   types.StringNull(),
+				ExternalApiSource: types.Int32Null(),
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if c.expectedError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != c.expectedError {
+					t.Fatalf("expected %s, got %v", c.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual ExternalAPIDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if actual.ExternalApiName.ValueString() != "Subscriber DB" || actual.ExternalApiSource.ValueInt32() != 4 {
+				t.Errorf("unexpected external API: %s %s", actual.ExternalApiName, actual.ExternalApiSource)
+			}
+			// the form fields are sorted by order
+			expectedFields := []ExternalAPIFieldDataSourceModel{
+				{
+					FieldName:    types.StringValue("member_id"),
+					FieldTitle:   types.StringValue("Member ID"),
+					Description:  types.StringValue("Your member ID"),
+					Mandatory:    types.BoolValue(true),
+					Hidden:       types.BoolValue(false),
+					DefaultValue: types.StringValue("M-"),
+					Order:        types.Int32Value(1),
+					Type:         types.StringValue("input"),
+					Editable:     types.StringValue("true"),
+				},
+				{
+					FieldName:    types.StringValue("zip"),
+					FieldTitle:   types.StringValue("Zip"),
+					Description:  types.StringValue(""),
+					Mandatory:    types.BoolValue(false),
+					Hidden:       types.BoolValue(false),
+					DefaultValue: types.StringNull(),
+					Order:        types.Int32Value(2),
+					Type:         types.StringValue("input"),
+					Editable:     types.StringValue("true"),
+				},
+			}
+			if !reflect.DeepEqual(actual.ExternalApiFormFields, expectedFields) {
+				t.Errorf("expected form fields %v, got %v", expectedFields, actual.ExternalApiFormFields)
+			}
+		})
+	}
+}
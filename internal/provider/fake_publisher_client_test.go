@@ -0,0 +1,149 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"terraform-provider-piano/internal/piano_publisher"
+)
+
+// fakePublisherClient is an in-memory piano_publisher.ClientInterface for unit tests of resource CRUD logic.
+// Only the methods used by tested resources are implemented.
+// Calling any other method panics as the embedded interface is nil.
+type fakePublisherClient struct {
+	piano_publisher.ClientInterface
+	// resources holds piano.io resources keyed by "{aid}/{rid}".
+	resources map[string]piano_publisher.Resource
+	// errors holds errors returned by the method of the name instead of handling the request.
+	errors map[string]error
+	// calls records the names of the called methods in order.
+	calls  []string
+	nextId int
+	now    int
+}
+
+func newFakePublisherClient() *fakePublisherClient {
+	return &fakePublisherClient{
+		resources: map[string]piano_publisher.Resource{},
+		errors:    map[string]error{},
+		now:       1735657200,
+	}
+}
+
+// call records the method call and returns the error registered for the method.
+func (c *fakePublisherClient) call(name string) error {
+	c.calls = append(c.calls, name)
+	return c.errors[name]
+}
+
+// fakeResponse encodes the body as a successful piano.io API response with the additional fields.
+func fakeResponse(fields map[string]any) (*http.Response, error) {
+	body := map[string]any{"code": 0}
+	for key, value := range fields {
+		body[key] = value
+	}
+	return fakeJsonResponse(body)
+}
+
+// fakeErrorResponse encodes a piano.io API error response.
+// piano.io API responds with 200 OK and reports the error in the body.
+func fakeErrorResponse(code int, message string) (*http.Response, error) {
+	return fakeJsonResponse(map[string]any{"code": code, "message": message})
+}
+
+func fakeJsonResponse(body map[string]any) (*http.Response, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(raw)),
+	}, nil
+}
+
+func (c *fakePublisherClient) PostPublisherResourceCreateWithFormdataBody(ctx context.Context, body piano_publisher.PostPublisherResourceCreateFormdataRequestBody, reqEditors ...piano_publisher.RequestEditorFn) (*http.Response, error) {
+	if err := c.call("PostPublisherResourceCreate"); err != nil {
+		return nil, err
+	}
+	c.nextId++
+	data := piano_publisher.Resource{
+		Aid:         body.Aid,
+		Rid:         fmt.Sprintf("R%07d", c.nextId),
+		Name:        body.Name,
+		Description: body.Description,
+		Type:        piano_publisher.ResourceTypeStandard,
+		TypeLabel:   piano_publisher.ResourceTypeLabelStandard,
+		CreateDate:  c.now,
+		UpdateDate:  c.now,
+	}
+	c.resources[data.Aid+"/"+data.Rid] = data
+	return fakeResponse(map[string]any{"resource": data})
+}
+
+func (c *fakePublisherClient) GetPublisherResourceGet(ctx context.Context, params *piano_publisher.GetPublisherResourceGetParams, reqEditors ...piano_publisher.RequestEditorFn) (*http.Response, error) {
+	if err := c.call("GetPublisherResourceGet"); err != nil {
+		return nil, err
+	}
+	data, ok := c.resources[params.Aid+"/"+params.Rid]
+	if !ok {
+		return fakeErrorResponse(404, "Resource not found")
+	}
+	return fakeResponse(map[string]any{"resource": data})
+}
+
+func (c *fakePublisherClient) PostPublisherResourceUpdateWithFormdataBody(ctx context.Context, body piano_publisher.PostPublisherResourceUpdateFormdataRequestBody, reqEditors ...piano_publisher.RequestEditorFn) (*http.Response, error) {
+	if err := c.call("PostPublisherResourceUpdate"); err != nil {
+		return nil, err
+	}
+	data, ok := c.resources[body.Aid+"/"+body.Rid]
+	if !ok {
+		return fakeErrorResponse(404, "Resource not found")
+	}
+	if body.Name != nil {
+		data.Name = *body.Name
+	}
+	if body.Description != nil {
+		data.Description = body.Description
+	}
+	if body.Disabled != nil {
+		data.Disabled = *body.Disabled
+	}
+	if body.ExternalId != nil {
+		data.ExternalId = body.ExternalId
+	}
+	if body.ImageUrl != nil {
+		data.ImageUrl = body.ImageUrl
+	}
+	if body.IsFbiaResource != nil {
+		data.IsFbiaResource = *body.IsFbiaResource
+	}
+	if body.ResourceUrl != nil {
+		data.ResourceUrl = body.ResourceUrl
+	}
+	if body.PublishDate != nil {
+		data.PublishDate = *body.PublishDate
+	}
+	c.now++
+	data.UpdateDate = c.now
+	c.resources[data.Aid+"/"+data.Rid] = data
+	return fakeResponse(map[string]any{"resource": data})
+}
+
+func (c *fakePublisherClient) PostPublisherResourceDeleteWithFormdataBody(ctx context.Context, body piano_publisher.PostPublisherResourceDeleteFormdataRequestBody, reqEditors ...piano_publisher.RequestEditorFn) (*http.Response, error) {
+	if err := c.call("PostPublisherResourceDelete"); err != nil {
+		return nil, err
+	}
+	if _, ok := c.resources[body.Aid+"/"+body.Rid]; !ok {
+		return fakeErrorResponse(404, "Resource not found")
+	}
+	delete(c.resources, body.Aid+"/"+body.Rid)
+	return fakeResponse(nil)
+}
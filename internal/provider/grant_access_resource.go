@@ -0,0 +1,288 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &GrantAccessResource{}
+	_ resource.ResourceWithModifyPlan  = &GrantAccessResource{}
+	_ resource.ResourceWithImportState = &GrantAccessResource{}
+)
+
+type GrantAccessResource struct {
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+}
+
+func NewGrantAccessResource() resource.Resource {
+	return &GrantAccessResource{}
+}
+
+type GrantAccessResourceModel struct {
+	Aid        types.String `tfsdk:"aid"`         // The application ID
+	Rid        types.String `tfsdk:"rid"`         // The resource ID
+	Uid        types.String `tfsdk:"uid"`         // The user ID
+	ExpireDate types.Int64  `tfsdk:"expire_date"` // The expire date of the access; null means unlimited
+	AccessId   types.String `tfsdk:"access_id"`   // The access ID
+	StartDate  types.Int64  `tfsdk:"start_date"`  // The start date
+}
+
+func (r *GrantAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *GrantAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+}
+
+func (r *GrantAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant_access"
+}
+
+func (*GrantAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "GrantAccess resource grants a user access to a resource without a purchase, for example to comp access for VIPs. " +
+			"Destroying this resource revokes the access. The resource is removed from state when the granted access is no longer effective, " +
+			"e.g. after it expires, so that another access of the user such as a paid subscription is never revoked.",
+		Attributes: map[string]schema.Attribute{
+			"aid": defaultAidAttribute(),
+			"rid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The user ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expire_date": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The expire date of the access in unix time. The access does not expire when this value is null.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					unixTimeValidator{},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					// piano.io API cannot remove the expire date from an access, so the access is granted again.
+					int64planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = req.PlanValue.IsNull() && !req.StateValue.IsNull()
+					}, "Removing expire_date grants the access again", "Removing `expire_date` grants the access again"),
+				},
+			},
+			"access_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The access ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_date": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The start date",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GrantAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state GrantAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("granting access to %s for %s in %s", state.Rid.ValueString(), state.Uid.ValueString(), state.Aid.ValueString()))
+	response, err := r.client.GetPublisherUserAccessGrant(ctx, &piano_publisher.GetPublisherUserAccessGrantParams{
+		Aid:        state.Aid.ValueString(),
+		Rid:        state.Rid.ValueString(),
+		Uid:        state.Uid.ValueStringPointer(),
+		ExpireDate: state.ExpireDate.ValueInt64Pointer(),
+		SendEmail:  false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant access, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.AccessArrayResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	if len(result.Access) == 0 {
+		resp.Diagnostics.AddError("Unexpected Response", fmt.Sprintf("piano.io API granted no access to %s for %s", state.Rid.ValueString(), state.Uid.ValueString()))
+		return
+	}
+	data := result.Access[0]
+	state.AccessId = types.StringValue(data.AccessId)
+	state.StartDate = types.Int64Value(int64(data.StartDate))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GrantAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GrantAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response, err := r.client.GetPublisherUserAccessCheck(ctx, &piano_publisher.GetPublisherUserAccessCheckParams{
+		Aid: state.Aid.ValueString(),
+		Uid: state.Uid.ValueString(),
+		Rid: state.Rid.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check access, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.AccessDTOResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	data := result.AccessDTO
+	if !data.Granted {
+		tflog.Warn(ctx, fmt.Sprintf("access to %s for %s is not granted. removing it from state", state.Rid.ValueString(), state.Uid.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	// The check endpoint reports access from any source such as paid subscriptions.
+	// The access is kept only while it is the one granted by this resource, so that destroying it never revokes another access.
+	if data.AccessId != state.AccessId.ValueString() {
+		tflog.Warn(ctx, fmt.Sprintf("access %s to %s for %s is no longer effective, got access %q. removing it from state", state.AccessId.ValueString(), state.Rid.ValueString(), state.Uid.ValueString(), data.AccessId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.StartDate = types.Int64Value(int64(data.StartDate))
+	// piano.io API returns 0 for accesses that never expire
+	if data.ExpireDate == 0 {
+		state.ExpireDate = types.Int64Null()
+	} else {
+		state.ExpireDate = types.Int64Value(int64(data.ExpireDate))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GrantAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state GrantAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var expireDate *int
+	if !state.ExpireDate.IsNull() {
+		value := int(state.ExpireDate.ValueInt64())
+		expireDate = &value
+	}
+	response, err := r.client.PostPublisherUserAccessUpdateWithFormdataBody(ctx, piano_publisher.PostPublisherUserAccessUpdateFormdataRequestBody{
+		AccessId:   state.AccessId.ValueString(),
+		ExpireDate: expireDate,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access, got error: %s", err))
+		return
+	}
+	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GrantAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GrantAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.AccessId.ValueString() == "" {
+		tflog.Warn(ctx, fmt.Sprintf("access to %s for %s has no access_id. removing it from state without revoking", state.Rid.ValueString(), state.Uid.ValueString()))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("revoking access %s to %s for %s", state.AccessId.ValueString(), state.Rid.ValueString(), state.Uid.ValueString()))
+	response, err := r.client.GetPublisherUserAccessRevoke(ctx, &piano_publisher.GetPublisherUserAccessRevokeParams{
+		AccessId: state.AccessId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke access, got error: %s", err))
+		return
+	}
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+}
+
+func (r *GrantAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := GrantAccessResourceIdFromString(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid grant access resource id", fmt.Sprintf("Unable to parse grant access resource id, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aid"), id.Aid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rid"), id.Rid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid"), id.Uid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_id"), id.AccessId)...)
+}
+
+// GrantAccessResourceId represents a grant access resource identifier in "{aid}/{rid}/{uid}/{access_id}" format.
+// access_id is required as the user may have other access to the resource, e.g. by a paid subscription, which must not be revoked.
+type GrantAccessResourceId struct {
+	Aid      string
+	Rid      string
+	Uid      string
+	AccessId string
+}
+
+func GrantAccessResourceIdFromString(input string) (*GrantAccessResourceId, error) {
+	parts := strings.Split(input, "/")
+	if len(parts) != 4 || parts[3] == "" {
+		return nil, errors.New("grant access resource id must be in {aid}/{rid}/{uid}/{access_id} format")
+	}
+	return &GrantAccessResourceId{Aid: parts[0], Rid: parts[1], Uid: parts[2], AccessId: parts[3]}, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGrantAccessResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/publisher/user/access/grant" {
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+		query := req.URL.Query()
+		if query.Get("rid") != "RXXXXXX" || query.Get("uid") != "UXXXXXX" || query.Get("expire_date") != "1767193200" {
+			t.Errorf("unexpected query: %s", req.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"Access":[{"access_id":"AXXXXXX","granted":true,"start_date":1735657200,"expire_date":1767193200}]}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &GrantAccessResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &GrantAccessResourceModel{
+		Aid:        types.StringValue("example"),
+		Rid:        types.StringValue("RXXXXXX"),
+		Uid:        types.StringValue("UXXXXXX"),
+		ExpireDate: types.Int64Value(1767193200),
+		AccessId:   types.StringUnknown(),
+		StartDate:  types.Int64Unknown(),
+	})
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual GrantAccessResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if actual.AccessId.ValueString() != "AXXXXXX" || actual.StartDate.ValueInt64() != 1735657200 {
+		t.Errorf("unexpected access: %s %s", actual.AccessId, actual.StartDate)
+	}
+}
+
+func TestGrantAccessResourceRead(t *testing.T) {
+	cases := []struct {
+		name               string
+		response           string
+		expectedRemoved    bool
+		expectedExpireDate types.Int64
+	}{
+		{name: "granted access", response: `{"code":0,"AccessDTO":{"access_id":"AXXXXXX","granted":true,"start_date":1735657200,"expire_date":1767193200}}`, expectedExpireDate: types.Int64Value(1767193200)},
+		{name: "unlimited access", response: `{"code":0,"AccessDTO":{"access_id":"AXXXXXX","granted":true,"start_date":1735657200,"expire_date":0}}`, expectedExpireDate: types.Int64Null()},
+		{name: "revoked access", response: `{"code":0,"AccessDTO":{"granted":false}}`, expectedRemoved: true},
+		{name: "other access", response: `{"code":0,"AccessDTO":{"access_id":"APAIDXX","granted":true,"start_date":1735657200,"expire_date":0}}`, expectedRemoved: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/user/access/check" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &GrantAccessResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, &GrantAccessResourceModel{
+				Aid:        types.StringValue("example"),
+				Rid:        types.StringValue("RXXXXXX"),
+				Uid:        types.StringValue("UXXXXXX"),
+				ExpireDate: types.Int64Null(),
+				AccessId:   types.StringValue("AXXXXXX"),
+				StartDate:  types.Int64Null(),
+			})
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != c.expectedRemoved {
+				t.Fatalf("expected resource to be removed: %t, got %v", c.expectedRemoved, resp.State.Raw)
+			}
+			if c.expectedRemoved {
+				return
+			}
+			var actual GrantAccessResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if actual.AccessId.ValueString() != "AXXXXXX" {
+				t.Errorf("expected access_id to be AXXXXXX, got %s", actual.AccessId)
+			}
+			if !actual.ExpireDate.Equal(c.expectedExpireDate) {
+				t.Errorf("expected expire_date to be %s, got %s", c.expectedExpireDate, actual.ExpireDate)
+			}
+		})
+	}
+}
+
+func TestGrantAccessResourceDeleteWithoutAccessId(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request: %s", req.URL)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &GrantAccessResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := newTestState(t, schemaResp.Schema, &GrantAccessResourceModel{
+		Aid:        types.StringValue("example"),
+		Rid:        types.StringValue("RXXXXXX"),
+		Uid:        types.StringValue("UXXXXXX"),
+		ExpireDate: types.Int64Null(),
+		AccessId:   types.StringNull(),
+		StartDate:  types.Int64Null(),
+	})
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestGrantAccessResourceIdFromString(t *testing.T) {
+	id, err := GrantAccessResourceIdFromString("example/RXXXXXX/UXXXXXX/AXXXXXX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.AccessId != "AXXXXXX" || id.Uid != "UXXXXXX" {
+		t.Errorf("unexpected id: %v", id)
+	}
+	if _, err := GrantAccessResourceIdFromString("example/RXXXXXX/UXXXXXX"); err == nil {
+		t.Error("expected an error for an id without access_id")
+	}
+}
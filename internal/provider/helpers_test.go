@@ -0,0 +1,139 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testSchema is the schema of a resource, a data source or the provider.
+type testSchema interface {
+	resourceschema.Schema | datasourceschema.Schema | providerschema.Schema
+}
+
+// newTestState returns a state of schema holding model.
+// A nil model returns a null state, such as the state of a response before the operation sets it.
+func newTestState[S testSchema](t *testing.T, schema S, model any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	state := tfsdk.State{}
+	switch schema := any(schema).(type) {
+	case resourceschema.Schema:
+		state.Schema = schema
+	case datasourceschema.Schema:
+		state.Schema = schema
+	case providerschema.Schema:
+		state.Schema = schema
+	}
+	state.Raw = tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)
+	if model == nil {
+		return state
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	return state
+}
+
+// newTestPlan returns a plan of schema holding model. See newTestState.
+func newTestPlan[S testSchema](t *testing.T, schema S, model any) tfsdk.Plan {
+	t.Helper()
+	state := newTestState(t, schema, model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// newTestConfig returns a configuration of schema holding model. See newTestState.
+func newTestConfig[S testSchema](t *testing.T, schema S, model any) tfsdk.Config {
+	t.Helper()
+	state := newTestState(t, schema, model)
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// newTestCreatePlan returns the plan to create the resource from config as terraform plans it.
+// Unset computed attributes are planned as their defaults or unknown, and then ModifyPlan of the resource runs.
+// Unlike a plan built from a model, it catches attributes that Create leaves unknown.
+func newTestCreatePlan(t *testing.T, r resource.Resource, schema resourceschema.Schema, config tfsdk.Config) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	configured := map[string]tftypes.Value{}
+	if err := config.Raw.As(&configured); err != nil {
+		t.Fatal(err)
+	}
+	// configured shares its map with config, so the plan is built in a copy of it.
+	values := maps.Clone(configured)
+	for name, attribute := range schema.Attributes {
+		if !values[name].IsNull() || !attribute.IsComputed() {
+			continue
+		}
+		value := defaultValueOf(ctx, attribute)
+		if value == nil {
+			values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), tftypes.UnknownValue)
+			continue
+		}
+		planned, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values[name] = planned
+	}
+	plan := tfsdk.Plan{Schema: schema, Raw: tftypes.NewValue(config.Raw.Type(), values)}
+	modifier, ok := r.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return plan
+	}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	modifier.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: newTestState(t, schema, nil)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	return resp.Plan
+}
+
+// defaultValueOf returns the default value of the attribute, or nil if it has none.
+func defaultValueOf(ctx context.Context, attribute resourceschema.Attribute) attr.Value {
+	switch attribute := attribute.(type) {
+	case resourceschema.StringAttribute:
+		if attribute.Default != nil {
+			resp := defaults.StringResponse{}
+			attribute.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+			return resp.PlanValue
+		}
+	case resourceschema.BoolAttribute:
+		if attribute.Default != nil {
+			resp := defaults.BoolResponse{}
+			attribute.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+			return resp.PlanValue
+		}
+	case resourceschema.Int32Attribute:
+		if attribute.Default != nil {
+			resp := defaults.Int32Response{}
+			attribute.Default.DefaultInt32(ctx, defaults.Int32Request{}, &resp)
+			return resp.PlanValue
+		}
+	case resourceschema.Int64Attribute:
+		if attribute.Default != nil {
+			resp := defaults.Int64Response{}
+			attribute.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+			return resp.PlanValue
+		}
+	case resourceschema.Float64Attribute:
+		if attribute.Default != nil {
+			resp := defaults.Float64Response{}
+			attribute.Default.DefaultFloat64(ctx, defaults.Float64Request{}, &resp)
+			return resp.PlanValue
+		}
+	}
+	return nil
+}
@@ -30,7 +30,7 @@ func NewLicenseeDataSource() datasource.DataSource {
 
 // LicenseeDataSource defines the data source implementation.
 type LicenseeDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 // LicenseeDataSourceModel describes the data source data model.
@@ -68,6 +68,7 @@ func (d *LicenseeDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			"aid": schema.StringAttribute{
 				MarkdownDescription: "piano application id",
 				Required:            true,
+				Validators:          aidValidators(),
 			},
 			"licensee_id": schema.StringAttribute{
 				MarkdownDescription: "The public ID of the licensee",
@@ -117,18 +118,9 @@ func (d *LicenseeDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 }
 
 func (d *LicenseeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *piano.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
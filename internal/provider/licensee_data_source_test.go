@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -19,12 +20,12 @@ func TestAccLicenseeDataSource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Read testing
 			{
-				Config: providerConfig + testAccExampleDataSourceConfig,
+				Config: testAccProviderConfig + testAccLicenseeDataSourceConfig(testAccAid()),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(
 						"data.piano_licensee.test",
 						tfjsonpath.New("aid"),
-						knownvalue.StringExact("example"),
+						knownvalue.StringExact(testAccAid()),
 					),
 					statecheck.ExpectKnownValue(
 						"data.piano_licensee.test",
@@ -37,9 +38,11 @@ func TestAccLicenseeDataSource(t *testing.T) {
 	})
 }
 
-const testAccExampleDataSourceConfig = `
+func testAccLicenseeDataSourceConfig(aid string) string {
+	return fmt.Sprintf(`
 data "piano_licensee" "test" {
-  aid = "example"
+  aid         = %q
   licensee_id = "example"
 }
-`
+`, aid)
+}
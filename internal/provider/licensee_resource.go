@@ -24,6 +24,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &LicenseeResource{}
+	_ resource.ResourceWithModifyPlan  = &LicenseeResource{}
 	_ resource.ResourceWithImportState = &LicenseeResource{}
 )
 
@@ -33,7 +34,8 @@ func NewLicenseeResource() resource.Resource {
 
 // LicenseeResource defines the resource implementation.
 type LicenseeResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
 }
 
 // LicenseeResourceModel describes the resource model.
@@ -68,10 +70,7 @@ func (r *LicenseeResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Licensee resource. Licensee is a company that has access to resources in the app.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				MarkdownDescription: "piano application id",
-				Required:            true,
-			},
+			"aid": defaultAidAttribute(),
 			"licensee_id": schema.StringAttribute{
 				MarkdownDescription: "The public ID of the licensee",
 				Computed:            true,
@@ -132,22 +131,18 @@ func (r *LicenseeResource) Schema(ctx context.Context, req resource.SchemaReques
 }
 
 func (r *LicenseeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *LicenseeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (r *LicenseeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -340,7 +335,7 @@ func (r *LicenseeResource) Delete(ctx context.Context, req resource.DeleteReques
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete licensee, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -28,7 +28,7 @@ func NewMaskedLicenseeDataSource() datasource.DataSource {
 
 // MaskedLicenseeDataSource defines the data source implementation.
 type MaskedLicenseeDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 // MaskedLicenseeDataSourceModel describes the data source data model.
@@ -54,6 +54,7 @@ func (d *MaskedLicenseeDataSource) Schema(ctx context.Context, req datasource.Sc
 			"aid": schema.StringAttribute{
 				MarkdownDescription: "piano application id",
 				Required:            true,
+				Validators:          aidValidators(),
 			},
 			"licensee_id": schema.StringAttribute{
 				MarkdownDescription: "The public ID of the licensee",
@@ -95,18 +96,9 @@ func (d *MaskedLicenseeDataSource) Schema(ctx context.Context, req datasource.Sc
 }
 
 func (d *MaskedLicenseeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
@@ -0,0 +1,108 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is how long before its expiry an access token is refreshed.
+const tokenExpiryMargin = time.Minute
+
+// clientCredentialsTokenSource fetches access tokens with OAuth 2.0 client credentials grant and caches them until they expire.
+// The token endpoint is called with a client which does not log requests, so that client_secret and access tokens do not leak into debug logs.
+type clientCredentialsTokenSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	client       *http.Client
+	now          func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func newClientCredentialsTokenSource(tokenURL string, clientID string, clientSecret string, client *http.Client) *clientCredentialsTokenSource {
+	return &clientCredentialsTokenSource{
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		client:       client,
+		now:          time.Now,
+	}
+}
+
+// tokenResponse is the successful response of the token endpoint. See RFC 6749 section 5.1.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Token returns a cached access token or fetches a new one when it is missing or about to expire.
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && (s.expiry.IsZero() || s.now().Add(tokenExpiryMargin).Before(s.expiry)) {
+		return s.token, nil
+	}
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+	response, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to fetch access token: %w", err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read access token response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %s: %s", response.Status, body)
+	}
+	result := tokenResponse{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("unable to decode access token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint returned no access token")
+	}
+	if result.TokenType != "" && !strings.EqualFold(result.TokenType, "bearer") {
+		return "", fmt.Errorf("token endpoint returned unsupported token type %s", result.TokenType)
+	}
+	s.token = result.AccessToken
+	s.expiry = time.Time{}
+	if result.ExpiresIn > 0 {
+		s.expiry = s.now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return s.token, nil
+}
+
+// bearerTokenRequestEditor authorizes requests with an access token from source. It is shared by piano publisher and piano ID clients.
+func bearerTokenRequestEditor(source *clientCredentialsTokenSource) func(ctx context.Context, req *http.Request) error {
+	return func(ctx context.Context, req *http.Request) error {
+		token, err := source.Token(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
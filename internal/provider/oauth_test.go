@@ -0,0 +1,110 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	ctx := context.Background()
+	issued := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if actual := req.PostForm.Get("grant_type"); actual != "client_credentials" {
+			t.Errorf("expected client_credentials grant, got %s", actual)
+		}
+		clientID, clientSecret, ok := req.BasicAuth()
+		if !ok || clientID != "client" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_client"}`)
+			return
+		}
+		issued++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, issued)
+	}))
+	defer server.Close()
+
+	now := time.Unix(1700000000, 0)
+	source := newClientCredentialsTokenSource(server.URL, "client", "secret", server.Client())
+	source.now = func() time.Time { return now }
+	for _, expected := range []string{"token-1", "token-1"} {
+		token, err := source.Token(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != expected {
+			t.Errorf("expected %s, got %s", expected, token)
+		}
+	}
+	// The token is refreshed shortly before it expires.
+	now = now.Add(3600*time.Second - tokenExpiryMargin)
+	token, err := source.Token(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "token-2" {
+		t.Errorf("expected refreshed token-2, got %s", token)
+	}
+
+	invalid := newClientCredentialsTokenSource(server.URL, "client", "wrong", server.Client())
+	if _, err := invalid.Token(ctx); err == nil {
+		t.Error("expected an error for invalid client credentials")
+	}
+}
+
+func TestProviderClientsUseClientCredentials(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/oauth/token":
+			fmt.Fprint(w, `{"access_token":"access","token_type":"bearer","expires_in":3600}`)
+		case "/api/v3/publisher/app/get":
+			if actual := req.Header.Get("Authorization"); actual != "Bearer access" {
+				t.Errorf("expected bearer token, got %q", actual)
+			}
+			if actual := req.Header.Get("API_TOKEN"); actual != "" {
+				t.Errorf("expected no API token, got %q", actual)
+			}
+			fmt.Fprint(w, `{"code":0}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+	defer server.Close()
+
+	p := &PianoProvider{version: "test"}
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	config := newTestConfig(t, schemaResp.Schema, &PianoProviderModel{
+		Endpoint:     types.StringValue(server.URL + "/api/v3"),
+		ClientId:     types.StringValue("client"),
+		ClientSecret: types.StringValue("secret"),
+		TokenUrl:     types.StringValue(server.URL + "/oauth/token"),
+	})
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	providerData := resp.ResourceData.(*PianoProviderData)
+	response, err := providerData.publisherClient.GetPublisherAppGet(ctx, &piano_publisher.GetPublisherAppGetParams{Aid: "example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+}
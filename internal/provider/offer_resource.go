@@ -23,32 +23,31 @@ import (
 
 var (
 	_ resource.Resource                = &OfferResource{}
+	_ resource.ResourceWithModifyPlan  = &OfferResource{}
 	_ resource.ResourceWithImportState = &OfferResource{}
 )
 
 type OfferResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
 }
 
 func NewOfferResource() resource.Resource {
 	return &OfferResource{}
 }
 func (r *OfferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *OfferResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (r *OfferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,10 +63,7 @@ type OfferResourceModel struct {
 func (*OfferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"offer_id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -111,6 +107,9 @@ func (r *OfferResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	data := result.Offer
 	state.OfferId = types.StringValue(data.OfferId)
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
+	}
 	state.Aid = types.StringValue(data.Aid)
 
 	state.Name = types.StringValue(data.Name)
@@ -197,7 +196,7 @@ func (r *OfferResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete licensee, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,212 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &OfferTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &OfferTemplateDataSource{}
+)
+
+// OfferTemplateDataSource defines the data source implementation.
+type OfferTemplateDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+func NewOfferTemplateDataSource() datasource.DataSource {
+	return &OfferTemplateDataSource{}
+}
+
+// OfferTemplateDataSourceModel describes the data source data model.
+type OfferTemplateDataSourceModel struct {
+	Aid             types.String                               `tfsdk:"aid"`               // The application ID
+	OfferTemplateId types.String                               `tfsdk:"offer_template_id"` // The template ID
+	Name            types.String                               `tfsdk:"name"`              // The name
+	Description     types.String                               `tfsdk:"description"`       // The description
+	Version         types.Int32                                `tfsdk:"version"`           // The template version
+	Status          types.String                               `tfsdk:"status"`            // The status
+	Type            types.String                               `tfsdk:"type"`              // The type
+	CategoryId      types.String                               `tfsdk:"category_id"`       // The category ID
+	Published       types.Bool                                 `tfsdk:"published"`         // Whether the template is published
+	CreateDate      types.Int64                                `tfsdk:"create_date"`       // The creation date
+	UpdateDate      types.Int64                                `tfsdk:"update_date"`       // The update date
+	ContentFields   []OfferTemplateContentFieldDataSourceModel `tfsdk:"content_fields"`    // The content fields of the template
+}
+
+type OfferTemplateContentFieldDataSourceModel struct {
+	ContentFieldId types.String `tfsdk:"content_field_id"` // The content field ID
+	Name           types.String `tfsdk:"name"`             // The name
+	Description    types.String `tfsdk:"description"`      // The description
+	Value          types.String `tfsdk:"value"`            // The content field value
+}
+
+func (r *OfferTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+}
+func (r *OfferTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_offer_template"
+}
+
+// offerTemplateComputedAttributes are the attributes of an offer template shared by piano_offer_template and piano_offer_templates.
+func offerTemplateComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description",
+		},
+		"version": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The template version",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status: `active` or `archived`",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type",
+		},
+		"category_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The category ID",
+		},
+		"published": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the template is published",
+		},
+		"create_date": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The creation date",
+		},
+		"update_date": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The update date",
+		},
+		"content_fields": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The content fields of the template",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"content_field_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The content field ID",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The name",
+					},
+					"description": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The description",
+					},
+					"value": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The content field value",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (*OfferTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := offerTemplateComputedAttributes()
+	attributes["aid"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The application ID",
+		Validators:          aidValidators(),
+	}
+	attributes["offer_template_id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The template ID",
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Offer template data source. Offer templates control the checkout UI of offers.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *OfferTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OfferTemplateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response, err := r.client.GetPublisherOfferTemplateGet(ctx, &piano_publisher.GetPublisherOfferTemplateGetParams{
+		Aid:             state.Aid.ValueString(),
+		OfferTemplateId: state.OfferTemplateId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch offer template, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.OfferTemplateVersionResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	state = OfferTemplateDataSourceModelFrom(result.OfferTemplateVersion)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func OfferTemplateDataSourceModelFrom(data piano_publisher.OfferTemplateVersion) OfferTemplateDataSourceModel {
+	return OfferTemplateDataSourceModel{
+		Aid:             types.StringValue(data.Aid),
+		OfferTemplateId: types.StringValue(data.OfferTemplateId),
+		Name:            types.StringValue(data.Name),
+		Description:     types.StringValue(data.Description),
+		Version:         types.Int32Value(data.Version),
+		Status:          types.StringValue(string(data.Status)),
+		Type:            types.StringValue(string(data.Type)),
+		CategoryId:      types.StringValue(string(data.CategoryId)),
+		Published:       types.BoolValue(data.Published),
+		CreateDate:      types.Int64Value(int64(data.CreateDate)),
+		UpdateDate:      types.Int64Value(int64(data.UpdateDate)),
+		ContentFields:   offerTemplateContentFieldsFrom(data.ContentFieldList),
+	}
+}
+
+// offerTemplateContentFieldsFrom converts content fields of a template. Deleted content fields are left out.
+func offerTemplateContentFieldsFrom(data []piano_publisher.OfferTemplateContentField) []OfferTemplateContentFieldDataSourceModel {
+	ret := []OfferTemplateContentFieldDataSourceModel{}
+	for _, field := range data {
+		if field.Deleted {
+			continue
+		}
+		ret = append(ret, OfferTemplateContentFieldDataSourceModel{
+			ContentFieldId: types.StringValue(field.ContentFieldId),
+			Name:           types.StringValue(field.Name),
+			Description:    types.StringValue(field.Description),
+			Value:          types.StringValue(field.Value),
+		})
+	}
+	return ret
+}
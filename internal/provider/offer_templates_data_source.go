@@ -0,0 +1,156 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &OfferTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &OfferTemplatesDataSource{}
+)
+
+// OfferTemplatesDataSource defines the data source implementation.
+type OfferTemplatesDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+func NewOfferTemplatesDataSource() datasource.DataSource {
+	return &OfferTemplatesDataSource{}
+}
+
+// OfferTemplatesDataSourceModel describes the data source data model.
+type OfferTemplatesDataSourceModel struct {
+	Aid            types.String                   `tfsdk:"aid"`             // The application ID
+	Status         types.String                   `tfsdk:"status"`          // The template status to filter by
+	OfferTemplates []OfferTemplateDataSourceModel `tfsdk:"offer_templates"` // The offer templates of the application
+}
+
+func (r *OfferTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+}
+func (r *OfferTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_offer_templates"
+}
+
+func (*OfferTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := offerTemplateComputedAttributes()
+	attributes["aid"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The application ID",
+	}
+	attributes["offer_template_id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The template ID",
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Offer templates data source. This data source is used to list offer templates of an application.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The template status to filter by. Active templates are listed when this value is null.",
+				Validators: []validator.String{stringvalidator.OneOf(
+					string(piano_publisher.GetPublisherOfferTemplateListParamsStatusActive),
+					string(piano_publisher.GetPublisherOfferTemplateListParamsStatusArchived),
+				)},
+			},
+			"offer_templates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The offer templates of the application",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *OfferTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OfferTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := piano_publisher.GetPublisherOfferTemplateListParamsStatusActive
+	if !state.Status.IsNull() {
+		status = piano_publisher.GetPublisherOfferTemplateListParamsStatus(state.Status.ValueString())
+	}
+	data, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.OfferTemplate, int, error) {
+		params := piano_publisher.GetPublisherOfferTemplateListParams{
+			Aid:    state.Aid.ValueString(),
+			Status: &status,
+			Offset: int32(offset),
+			Limit:  int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching offer templates in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := r.client.GetPublisherOfferTemplateList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch offer templates, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.OfferTemplateArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.OfferTemplate, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	offerTemplates := []OfferTemplateDataSourceModel{}
+	for _, offerTemplate := range data {
+		offerTemplates = append(offerTemplates, OfferTemplateDataSourceModelFromOfferTemplate(offerTemplate))
+	}
+
+	state.OfferTemplates = offerTemplates
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func OfferTemplateDataSourceModelFromOfferTemplate(data piano_publisher.OfferTemplate) OfferTemplateDataSourceModel {
+	return OfferTemplateDataSourceModel{
+		Aid:             types.StringValue(data.Aid),
+		OfferTemplateId: types.StringValue(data.OfferTemplateId),
+		Name:            types.StringValue(data.Name),
+		Description:     types.StringValue(data.Description),
+		Version:         types.Int32Value(data.Version),
+		Status:          types.StringValue(string(data.Status)),
+		Type:            types.StringValue(string(data.Type)),
+		CategoryId:      types.StringValue(string(data.CategoryId)),
+		Published:       types.BoolValue(data.IsPublished),
+		CreateDate:      types.Int64Value(int64(data.CreateDate)),
+		UpdateDate:      types.Int64Value(int64(data.UpdateDate)),
+		ContentFields:   offerTemplateContentFieldsFrom(data.ContentFieldList),
+	}
+}
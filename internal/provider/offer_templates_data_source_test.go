@@ -0,0 +1,82 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOfferTemplatesDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	offsets := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/publisher/offer/template/list" {
+			t.Errorf("unexpected request: %s", req.URL.Path)
+		}
+		if status := req.URL.Query().Get("status"); status != "archived" {
+			t.Errorf("expected status=archived, got %s", status)
+		}
+		offsets = append(offsets, req.URL.Query().Get("offset"))
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("offset") != "0" {
+			fmt.Fprint(w, `{"code":0,"total":101,"OfferTemplate":[{"aid":"example","offer_template_id":"OTLAST","name":"last","status":"archived","content_field_list":[]}]}`)
+			return
+		}
+		templates := []string{
+			`{"aid":"example","offer_template_id":"OT0","name":"first","version":3,"status":"archived","is_published":true,"content_field_list":[` +
+				`{"content_field_id":"CF1","name":"title","value":"Subscribe"},{"content_field_id":"CF2","name":"removed","deleted":true}]}`,
+		}
+		for i := 1; i < syntax.PageSize; i++ {
+			templates = append(templates, fmt.Sprintf(`{"aid":"example","offer_template_id":"OT%d","status":"archived","content_field_list":[]}`, i))
+		}
+		fmt.Fprintf(w, `{"code":0,"total":101,"OfferTemplate":[%s]}`, strings.Join(templates, ","))
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &OfferTemplatesDataSource{client: client}
+
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	config := newTestConfig(t, schemaResp.Schema, &OfferTemplatesDataSourceModel{
+		Aid:    types.StringValue("example"),
+		Status: types.StringValue("archived"),
+	})
+
+	resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(offsets) != 2 || offsets[1] != "100" {
+		t.Errorf("expected two pages, got offsets %v", offsets)
+	}
+	var actual OfferTemplatesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(actual.OfferTemplates) != 101 || actual.OfferTemplates[100].OfferTemplateId.ValueString() != "OTLAST" {
+		t.Fatalf("expected 101 offer templates, got %d", len(actual.OfferTemplates))
+	}
+	first := actual.OfferTemplates[0]
+	if first.Name.ValueString() != "first" || first.Version.ValueInt32() != 3 || !first.Published.ValueBool() {
+		t.Errorf("unexpected offer template: %v", first)
+	}
+	if len(first.ContentFields) != 1 || first.ContentFields[0].Value.ValueString() != "Subscribe" {
+		t.Errorf("expected deleted content fields to be left out, got %v", first.ContentFields)
+	}
+}
@@ -23,32 +23,31 @@ import (
 
 var (
 	_ resource.Resource                = &OfferTermBindingResource{}
+	_ resource.ResourceWithModifyPlan  = &OfferTermBindingResource{}
 	_ resource.ResourceWithImportState = &OfferTermBindingResource{}
 )
 
 type OfferTermBindingResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
 }
 
 func NewOfferTermBindingResource() resource.Resource {
 	return &OfferTermBindingResource{}
 }
 func (r *OfferTermBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *OfferTermBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (r *OfferTermBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,13 +64,7 @@ func (*OfferTermBindingResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "OfferTermBinding resource associates a term with an offer",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
+			"aid": defaultAidAttribute(),
 			"offer_id": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The offer ID",
@@ -165,7 +158,7 @@ func (r *OfferTermBindingResource) Delete(ctx context.Context, req resource.Dele
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create offer, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
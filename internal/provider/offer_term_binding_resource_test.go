@@ -0,0 +1,57 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOfferTermBindingResource(t *testing.T) {
+	name := testAccName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + testAccOfferTermBindingConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"piano_offer.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(name),
+					),
+					statecheck.ExpectKnownValue(
+						"piano_payment_term_v2.test",
+						tfjsonpath.New("aid"),
+						knownvalue.StringExact(testAccAid()),
+					),
+					statecheck.CompareValuePairs(
+						"piano_offer_term_binding.test",
+						tfjsonpath.New("term_id"),
+						"piano_payment_term_v2.test",
+						tfjsonpath.New("term_id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			// Applying the same configuration again plans no change, including computed attributes derived from payment_billing_plan
+			{
+				Config: testAccProviderConfig + testAccOfferTermBindingConfig(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
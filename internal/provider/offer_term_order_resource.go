@@ -20,32 +20,31 @@ import (
 
 var (
 	_ resource.Resource                = &OfferTermOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &OfferTermOrderResource{}
 	_ resource.ResourceWithImportState = &OfferTermOrderResource{}
 )
 
 type OfferTermOrderResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
 }
 
 func NewOfferTermOrderResource() resource.Resource {
 	return &OfferTermOrderResource{}
 }
 func (r *OfferTermOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *OfferTermOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (r *OfferTermOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,10 +61,7 @@ func (*OfferTermOrderResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "This resource defines the order of terms in an offer.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"offer_id": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The offer ID",
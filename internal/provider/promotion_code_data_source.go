@@ -0,0 +1,200 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &PromotionCodeDataSource{}
+	_ datasource.DataSourceWithConfigure = &PromotionCodeDataSource{}
+)
+
+// PromotionCodeDataSource defines the data source implementation.
+type PromotionCodeDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+func NewPromotionCodeDataSource() datasource.DataSource {
+	return &PromotionCodeDataSource{}
+}
+
+// PromotionCodeDataSourceModel describes the data source data model.
+type PromotionCodeDataSourceModel struct {
+	Aid           types.String `tfsdk:"aid"`            // The application ID
+	Code          types.String `tfsdk:"code"`           // The promo code itself
+	PromotionId   types.String `tfsdk:"promotion_id"`   // The promotion ID
+	PromotionName types.String `tfsdk:"promotion_name"` // The promotion name
+	PromoCodeId   types.String `tfsdk:"promo_code_id"`  // The promo code ID
+	State         types.String `tfsdk:"state"`          // The promo code state value
+}
+
+func (r *PromotionCodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+}
+func (r *PromotionCodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_promotion_code"
+}
+
+func (*PromotionCodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Promotion code data source. This data source is used to find the promotion of a promo code. " +
+			"piano.io API cannot look up a promo code by its value, so the promotions of the application and their codes are listed to find it. " +
+			"It may take many API calls for an application with many promotions.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
+			},
+			"code": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The promo code itself. It is either the fixed_promotion_code of a promotion or one of the codes generated for a promotion.",
+			},
+			"promotion_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the promotion the code belongs to",
+			},
+			"promotion_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the promotion the code belongs to",
+			},
+			"promo_code_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The promo code ID. This value is null when the code is the fixed_promotion_code of the promotion.",
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The promo code state: `active`, `reserved_for_delayed_payment`, `reserved_for_free_trial` or `used`. " +
+					"This value is null when the code is the fixed_promotion_code of the promotion as such a code is shared by all the customers.",
+			},
+		},
+	}
+}
+
+func (r *PromotionCodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state PromotionCodeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	aid := state.Aid.ValueString()
+	code := state.Code.ValueString()
+
+	expired := piano_publisher.GetPublisherPromotionListParamsExpiredAll
+	promotions, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.Promotion, int, error) {
+		params := piano_publisher.GetPublisherPromotionListParams{
+			Aid:     aid,
+			Expired: &expired,
+			Offset:  int32(offset),
+			Limit:   int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching promotions in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := r.client.GetPublisherPromotionList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch promotions, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.PromotionArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.Promotions, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	for _, promotion := range promotions {
+		state.PromotionId = types.StringValue(promotion.PromotionId)
+		state.PromotionName = types.StringValue(promotion.Name)
+		if promotion.FixedPromotionCode != nil && *promotion.FixedPromotionCode == code {
+			state.PromoCodeId = types.StringNull()
+			state.State = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		promoCode, ok := r.findPromoCode(ctx, aid, promotion.PromotionId, code, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		if promoCode != nil {
+			state.PromoCodeId = types.StringValue(promoCode.PromoCodeId)
+			state.State = types.StringValue(string(promoCode.StateValue))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("code"),
+		"Promotion Code Not Found",
+		fmt.Sprintf("No promotion in the application(%s) has the promo code %s.", aid, code),
+	)
+}
+
+// findPromoCode searches the codes of the promotion for the code. It returns nil when the promotion does not have the code.
+func (r *PromotionCodeDataSource) findPromoCode(ctx context.Context, aid string, promotionId string, code string, diagnostics *diag.Diagnostics) (*piano_publisher.PromoCode, bool) {
+	promoCodes, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.PromoCode, int, error) {
+		params := piano_publisher.GetPublisherPromotionCodeListParams{
+			Aid:         aid,
+			PromotionId: promotionId,
+			Q:           &code,
+			Offset:      int32(offset),
+			Limit:       int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching codes of promotion %s (offset: %d, limit: %d)", promotionId, params.Offset, params.Limit))
+		response, err := r.client.GetPublisherPromotionCodeList(ctx, &params)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch promotion codes, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.PromoCodeArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.Data, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return nil, false
+	}
+	// q matches codes partially, so only the exact one is taken.
+	for _, promoCode := range promoCodes {
+		if promoCode.Code == code {
+			return &promoCode, true
+		}
+	}
+	return nil, true
+}
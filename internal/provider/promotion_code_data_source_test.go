@@ -0,0 +1,82 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPromotionCodeDataSourceRead(t *testing.T) {
+	cases := []struct {
+		name          string
+		code          string
+		promotionId   string
+		promoCodeId   types.String
+		state         types.String
+		expectedError bool
+	}{
+		{name: "generated code", code: "SPRING", promotionId: "PR2", promoCodeId: types.StringValue("PC2"), state: types.StringValue("used")},
+		{name: "fixed code", code: "FIXED", promotionId: "PR1", promoCodeId: types.StringNull(), state: types.StringNull()},
+		{name: "unknown code", code: "UNKNOWN", expectedError: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch req.URL.Path {
+				case "/publisher/promotion/list":
+					fmt.Fprint(w, `{"code":0,"promotions":[{"promotion_id":"PR1","name":"fixed","fixed_promotion_code":"FIXED"},{"promotion_id":"PR2","name":"generated","fixed_promotion_code":null}]}`)
+				case "/publisher/promotion/code/list":
+					if req.URL.Query().Get("promotion_id") != "PR2" {
+						fmt.Fprint(w, `{"code":0,"data":[]}`)
+						return
+					}
+					fmt.Fprint(w, `{"code":0,"data":[{"promo_code_id":"PC1","promotion_id":"PR2","code":"SPRING2","state":"Active","state_value":"active"},{"promo_code_id":"PC2","promotion_id":"PR2","code":"SPRING","state":"Used","state_value":"used"}]}`)
+				default:
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &PromotionCodeDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &PromotionCodeDataSourceModel{
+				Aid:           types.StringValue("example"),
+				Code:          types.StringValue(c.code),
+				PromotionId:   types.StringNull(),
+				PromotionName: types.StringNull(),
+				PromoCodeId:   types.StringNull(),
+				State:         types.StringNull(),
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Fatalf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if c.expectedError {
+				return
+			}
+			var actual PromotionCodeDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if actual.PromotionId.ValueString() != c.promotionId || !actual.PromoCodeId.Equal(c.promoCodeId) || !actual.State.Equal(c.state) {
+				t.Errorf("unexpected promotion code: %v", actual)
+			}
+		})
+	}
+}
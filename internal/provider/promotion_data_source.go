@@ -26,24 +26,16 @@ var (
 
 // PromotionDataSource defines the resource implementation.
 type PromotionDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 func NewPromotionDataSource() datasource.DataSource {
 	return &PromotionDataSource{}
 }
 func (r *PromotionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
@@ -173,6 +165,7 @@ func (*PromotionDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 			"aid": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
 			},
 			"fixed_promotion_code": schema.StringAttribute{
 				Optional:            true,
@@ -314,38 +307,42 @@ func (r *PromotionDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	data := result.Promotion
-	state.Discount = types.StringValue(data.Discount)
-	state.UsesAllowed = types.Int32PointerValue(data.UsesAllowed)
-	state.CreateBy = types.StringValue(data.CreateBy)
-	state.PromotionCodePrefix = types.StringPointerValue(data.PromotionCodePrefix)
-	state.PromotionId = types.StringValue(data.PromotionId)
-	state.DiscountAmount = types.Float64Value(data.DiscountAmount)
-	state.UnlimitedUses = types.BoolValue(data.UnlimitedUses)
-	state.PercentageDiscount = types.Float64Value(data.PercentageDiscount)
-	state.Status = types.StringValue(string(data.Status))
-	state.NewCustomersOnly = types.BoolValue(data.NewCustomersOnly)
+	state = PromotionDataSourceModelFrom(result.Promotion)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+func PromotionDataSourceModelFrom(data piano_publisher.Promotion) PromotionDataSourceModel {
+	ret := PromotionDataSourceModel{}
+	ret.Discount = types.StringValue(data.Discount)
+	ret.UsesAllowed = types.Int32PointerValue(data.UsesAllowed)
+	ret.CreateBy = types.StringValue(data.CreateBy)
+	ret.PromotionCodePrefix = types.StringPointerValue(data.PromotionCodePrefix)
+	ret.PromotionId = types.StringValue(data.PromotionId)
+	ret.DiscountAmount = types.Float64Value(data.DiscountAmount)
+	ret.UnlimitedUses = types.BoolValue(data.UnlimitedUses)
+	ret.PercentageDiscount = types.Float64Value(data.PercentageDiscount)
+	ret.Status = types.StringValue(string(data.Status))
+	ret.NewCustomersOnly = types.BoolValue(data.NewCustomersOnly)
 	fixedDiscountListElements := []PromotionFixedDiscountDataSourceModel{}
-	for _, element := range data.FixedDiscountList {
+	for _, element := range sortedPromotionFixedDiscounts(data.FixedDiscountList) {
 		fixedDiscountListElements = append(fixedDiscountListElements, PromotionFixedDiscountDataSourceModelFrom(element))
 	}
-	state.FixedDiscountList = fixedDiscountListElements
-	state.EndDate = types.Int64Value(int64(data.EndDate))
-	state.NeverAllowZero = types.BoolValue(data.NeverAllowZero)
-	state.ApplyToAllBillingPeriods = types.BoolValue(data.ApplyToAllBillingPeriods)
-	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
-	state.DiscountCurrency = types.StringValue(data.DiscountCurrency)
-	state.CanBeAppliedOnRenewal = types.BoolValue(data.CanBeAppliedOnRenewal)
-	state.BillingPeriodLimit = types.Int32Value(data.BillingPeriodLimit)
-	state.Uses = types.Int32Value(data.Uses)
-	state.FixedPromotionCode = types.StringPointerValue(data.FixedPromotionCode)
-	state.Aid = types.StringValue(data.Aid)
-	state.UpdateBy = types.StringValue(data.UpdateBy)
-	state.Deleted = types.BoolValue(data.Deleted)
-	state.CreateDate = types.Int64Value(int64(data.CreateDate))
-	state.TermDependencyType = types.StringValue(string(data.TermDependencyType))
-	state.StartDate = types.Int64Value(int64(data.StartDate))
-	state.Name = types.StringValue(data.Name)
-	state.DiscountType = types.StringValue(string(data.DiscountType))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	ret.FixedDiscountList = fixedDiscountListElements
+	ret.EndDate = types.Int64Value(int64(data.EndDate))
+	ret.NeverAllowZero = types.BoolValue(data.NeverAllowZero)
+	ret.ApplyToAllBillingPeriods = types.BoolValue(data.ApplyToAllBillingPeriods)
+	ret.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	ret.DiscountCurrency = types.StringValue(data.DiscountCurrency)
+	ret.CanBeAppliedOnRenewal = types.BoolValue(data.CanBeAppliedOnRenewal)
+	ret.BillingPeriodLimit = types.Int32Value(data.BillingPeriodLimit)
+	ret.Uses = types.Int32Value(data.Uses)
+	ret.FixedPromotionCode = types.StringPointerValue(data.FixedPromotionCode)
+	ret.Aid = types.StringValue(data.Aid)
+	ret.UpdateBy = types.StringValue(data.UpdateBy)
+	ret.Deleted = types.BoolValue(data.Deleted)
+	ret.CreateDate = types.Int64Value(int64(data.CreateDate))
+	ret.TermDependencyType = types.StringValue(string(data.TermDependencyType))
+	ret.StartDate = types.Int64Value(int64(data.StartDate))
+	ret.Name = types.StringValue(data.Name)
+	ret.DiscountType = types.StringValue(string(data.DiscountType))
+	return ret
 }
@@ -8,11 +8,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"terraform-provider-piano/internal/piano"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,6 +38,7 @@ import (
 var (
 	_ resource.Resource                = &PromotionResource{}
 	_ resource.ResourceWithImportState = &PromotionResource{}
+	_ resource.ResourceWithModifyPlan  = &PromotionResource{}
 )
 
 func NewPromotionResource() resource.Resource {
@@ -39,25 +47,22 @@ func NewPromotionResource() resource.Resource {
 
 // PromotionResource defines the resource implementation.
 type PromotionResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
 }
 
 func (r *PromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
 }
 func (r *PromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_promotion"
@@ -82,8 +87,29 @@ type PromotionResourceModel struct {
 	CanBeAppliedOnRenewal    types.Bool                            `tfsdk:"can_be_applied_on_renewal"`    // Whether the promotion can be applied on renewal
 	BillingPeriodLimit       types.Int32                           `tfsdk:"billing_period_limit"`         // Promotion discount applies to number of billing periods
 	FixedDiscountList        []PromotionFixedDiscountResourceModel `tfsdk:"fixed_discount_list"`
-	CreateDate               types.Int64                           `tfsdk:"create_date"` // The creation date
-	UpdateDate               types.Int64                           `tfsdk:"update_date"` // The update date
+	CreateDate               types.Int64                           `tfsdk:"create_date"`     // The creation date
+	UpdateDate               types.Int64                           `tfsdk:"update_date"`     // The update date
+	Status                   types.String                          `tfsdk:"status"`          // The promotion status
+	Uses                     types.Int32                           `tfsdk:"uses"`            // How many times the promotion has been used
+	StartDateIso             types.String                          `tfsdk:"start_date_iso"`  // The start date in RFC3339 format
+	EndDateIso               types.String                          `tfsdk:"end_date_iso"`    // The end date in RFC3339 format
+	CreateDateIso            types.String                          `tfsdk:"create_date_iso"` // The creation date in RFC3339 format
+	UpdateDateIso            types.String                          `tfsdk:"update_date_iso"` // The update date in RFC3339 format
+	GenerateCodes            *PromotionGenerateCodesResourceModel  `tfsdk:"generate_codes"`
+	GeneratedCodeIds         types.List                            `tfsdk:"generated_code_ids"` // The IDs of the promotion codes generated by generate_codes
+}
+
+// setUnixTimeIso fills the `*_iso` attributes from the unix times in the given location.
+func (m *PromotionResourceModel) setUnixTimeIso(location *time.Location) {
+	m.StartDateIso = unixTimeIsoFrom(m.StartDate, location)
+	m.EndDateIso = unixTimeIsoFrom(m.EndDate, location)
+	m.CreateDateIso = unixTimeIsoFrom(m.CreateDate, location)
+	m.UpdateDateIso = unixTimeIsoFrom(m.UpdateDate, location)
+}
+
+type PromotionGenerateCodesResourceModel struct {
+	Count  types.Int64  `tfsdk:"count"`  // The number of promotion codes to generate
+	Prefix types.String `tfsdk:"prefix"` // The prefix for the generated codes
 }
 
 type PromotionFixedDiscountResourceModel struct {
@@ -99,10 +125,7 @@ func (*PromotionResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"For more details, see https://docs.piano.io/promotions/",
 		Attributes: map[string]schema.Attribute{
 			// always required
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			// required in request
 			"promotion_id": schema.StringAttribute{
 				Computed: true,
@@ -216,7 +239,10 @@ When the value is "unlocked", the promotion allows customers to access special t
 				PlanModifiers: []planmodifier.Float64{
 					float64planmodifier.UseStateForUnknown(),
 				},
-				MarkdownDescription: "The promotion discount, percentage",
+				MarkdownDescription: "The promotion discount, percentage. Must be between 0 and 100.",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
 			},
 			// filled with empty value in create response
 			"start_date": schema.Int64Attribute{
@@ -226,6 +252,7 @@ When the value is "unlocked", the promotion allows customers to access special t
 					int64planmodifier.UseStateForUnknown(),
 				},
 				MarkdownDescription: "The start date.",
+				Validators:          []validator.Int64{unixTimeValidator{}},
 			},
 			// filled with empty value in create response
 			"end_date": schema.Int64Attribute{
@@ -235,6 +262,7 @@ When the value is "unlocked", the promotion allows customers to access special t
 					int64planmodifier.UseStateForUnknown(),
 				},
 				MarkdownDescription: "The end date",
+				Validators:          []validator.Int64{unixTimeValidator{}},
 			},
 			// nullable in response
 			"promotion_code_prefix": schema.StringAttribute{
@@ -268,6 +296,46 @@ When the value is "unlocked", the promotion allows customers to access special t
 				Computed:            true,
 				MarkdownDescription: "The update date",
 			},
+			"generate_codes": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Generates promotion codes for the promotion. " +
+					"`count` is the total number of codes generated by this attribute: increasing it generates only the additional codes " +
+					"and it cannot be decreased as generated codes are not deleted. A change of `prefix` applies to the codes generated afterwards.",
+				Attributes: map[string]schema.Attribute{
+					"count": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "The total number of promotion codes to generate",
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"prefix": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The prefix for the generated codes",
+					},
+				},
+			},
+			// computed
+			"generated_code_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The IDs of the promotion codes generated by `generate_codes`, sorted by ID",
+			},
+			"start_date_iso":  unixTimeIsoAttribute("The start date"),
+			"end_date_iso":    unixTimeIsoAttribute("The end date"),
+			"create_date_iso": unixTimeIsoAttribute("The creation date"),
+			"update_date_iso": unixTimeIsoAttribute("The update date"),
+			// computed
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The promotion status",
+			},
+			// computed
+			"uses": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "How many times the promotion has been used",
+			},
 			// computed: this value determines the nullability of `use_allowed` field
 			"unlimited_uses": schema.BoolAttribute{
 				Computed:            true,
@@ -284,6 +352,28 @@ func PromotionFixedDiscountResourceModelFrom(data piano_publisher.PromotionFixed
 	ret.FixedDiscountId = types.StringValue(data.FixedDiscountId)
 	return ret
 }
+
+// PromotionFixedDiscountListResourceModelFrom converts fixed discounts into models sorted by currency.
+func PromotionFixedDiscountListResourceModelFrom(data []piano_publisher.PromotionFixedDiscount) []PromotionFixedDiscountResourceModel {
+	elements := []PromotionFixedDiscountResourceModel{}
+	for _, element := range sortedPromotionFixedDiscounts(data) {
+		elements = append(elements, PromotionFixedDiscountResourceModelFrom(element))
+	}
+	return elements
+}
+
+// sortedPromotionFixedDiscounts returns fixed discounts sorted by currency and then by fixed_discount_id.
+// piano.io API does not guarantee the order of fixed_discount_list, so it is sorted to avoid spurious diffs.
+func sortedPromotionFixedDiscounts(data []piano_publisher.PromotionFixedDiscount) []piano_publisher.PromotionFixedDiscount {
+	sorted := append([]piano_publisher.PromotionFixedDiscount{}, data...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Currency != sorted[j].Currency {
+			return sorted[i].Currency < sorted[j].Currency
+		}
+		return sorted[i].FixedDiscountId < sorted[j].FixedDiscountId
+	})
+	return sorted
+}
 func (r *PromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state PromotionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -309,39 +399,37 @@ func (r *PromotionResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "promotion_id", result.Promotion.PromotionId, &resp.Diagnostics) {
+		return
+	}
 
 	data := result.Promotion
 	state.UsesAllowed = types.Int32PointerValue(data.UsesAllowed)
-	if state.PromotionCodePrefix.IsNull() && data.PromotionCodePrefix != nil && *data.PromotionCodePrefix == "" {
-		state.PromotionCodePrefix = types.StringNull()
-	} else {
-		state.PromotionCodePrefix = types.StringPointerValue(data.PromotionCodePrefix)
-	}
+	state.PromotionCodePrefix = syntax.ReconcileOptionalString(state.PromotionCodePrefix, data.PromotionCodePrefix)
 	state.PromotionId = types.StringValue(data.PromotionId)
+	state.UnlimitedUses = types.BoolValue(data.UnlimitedUses)
 	state.PercentageDiscount = types.Float64Value(data.PercentageDiscount)
 	state.NewCustomersOnly = types.BoolValue(data.NewCustomersOnly)
-	fixedDiscountListElements := []PromotionFixedDiscountResourceModel{}
-	for _, element := range data.FixedDiscountList {
-		fixedDiscountListElements = append(fixedDiscountListElements, PromotionFixedDiscountResourceModelFrom(element))
-	}
-	state.FixedDiscountList = fixedDiscountListElements
-	state.EndDate = types.Int64Value(int64(data.EndDate))
+	state.FixedDiscountList = PromotionFixedDiscountListResourceModelFrom(data.FixedDiscountList)
+	state.EndDate = promotionDateFrom(state.EndDate, data.EndDate)
 	state.NeverAllowZero = types.BoolValue(data.NeverAllowZero)
 	state.ApplyToAllBillingPeriods = types.BoolValue(data.ApplyToAllBillingPeriods)
 	state.CanBeAppliedOnRenewal = types.BoolValue(data.CanBeAppliedOnRenewal)
 	state.BillingPeriodLimit = types.Int32Value(data.BillingPeriodLimit)
-	if state.FixedPromotionCode.IsNull() && data.FixedPromotionCode != nil && *data.FixedPromotionCode == "" {
-		state.FixedPromotionCode = types.StringNull()
-	} else {
-		state.FixedPromotionCode = types.StringPointerValue(data.FixedPromotionCode)
+	state.FixedPromotionCode = syntax.ReconcileOptionalString(state.FixedPromotionCode, data.FixedPromotionCode)
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
 	}
 	state.Aid = types.StringValue(data.Aid)
 	state.TermDependencyType = types.StringValue(string(data.TermDependencyType))
-	state.StartDate = types.Int64Value(int64(data.StartDate))
+	state.StartDate = promotionDateFrom(state.StartDate, data.StartDate)
 	state.Name = types.StringValue(data.Name)
 	state.DiscountType = types.StringValue(string(data.DiscountType))
 	state.CreateDate = types.Int64Value(int64(data.CreateDate))
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.Status = types.StringValue(string(data.Status))
+	state.Uses = types.Int32Value(data.Uses)
+	state.setUnixTimeIso(r.dateLocation)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -364,10 +452,9 @@ func (r *PromotionResource) Create(ctx context.Context, req resource.CreateReque
 		UsesAllowed:           state.UsesAllowed.ValueInt32Pointer(),
 		FixedPromotionCode:    state.FixedPromotionCode.ValueStringPointer(),
 	}
-	if state.UsesAllowed.IsNull() {
-		t := true
-		request.UnlimitedUses = &t
-	}
+	// unlimited_uses is reset explicitly so that switching back to limited uses takes effect
+	unlimitedUses := state.UsesAllowed.IsNull()
+	request.UnlimitedUses = &unlimitedUses
 	if state.StartDate.ValueInt64Pointer() != nil {
 		date := int(state.StartDate.ValueInt64())
 		request.StartDate = &date
@@ -396,42 +483,55 @@ func (r *PromotionResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "promotion_id", result.Promotion.PromotionId, &resp.Diagnostics) {
+		return
+	}
 
 	data := result.Promotion
 	state.UsesAllowed = types.Int32PointerValue(data.UsesAllowed)
-	state.PromotionCodePrefix = types.StringPointerValue(data.PromotionCodePrefix)
+	state.PromotionCodePrefix = syntax.ReconcileOptionalString(state.PromotionCodePrefix, data.PromotionCodePrefix)
 	state.PromotionId = types.StringValue(data.PromotionId)
 	state.UnlimitedUses = types.BoolValue(data.UnlimitedUses)
 	state.PercentageDiscount = types.Float64Value(data.PercentageDiscount)
 	state.NewCustomersOnly = types.BoolValue(data.NewCustomersOnly)
-	fixedDiscountListElements := []PromotionFixedDiscountResourceModel{}
-	for _, element := range data.FixedDiscountList {
-		fixedDiscountListElements = append(fixedDiscountListElements, PromotionFixedDiscountResourceModelFrom(element))
-	}
-	state.FixedDiscountList = fixedDiscountListElements
-	state.EndDate = types.Int64Value(int64(data.EndDate))
+	state.FixedDiscountList = PromotionFixedDiscountListResourceModelFrom(data.FixedDiscountList)
+	state.EndDate = promotionDateFrom(state.EndDate, data.EndDate)
 	state.NeverAllowZero = types.BoolValue(data.NeverAllowZero)
 	state.ApplyToAllBillingPeriods = types.BoolValue(data.ApplyToAllBillingPeriods)
 
 	state.CanBeAppliedOnRenewal = types.BoolValue(data.CanBeAppliedOnRenewal)
 	state.BillingPeriodLimit = types.Int32Value(data.BillingPeriodLimit)
-	if state.FixedPromotionCode.IsNull() && data.FixedPromotionCode != nil && *data.FixedPromotionCode == "" {
-		state.FixedPromotionCode = types.StringNull()
-	} else {
-		state.FixedPromotionCode = types.StringPointerValue(data.FixedPromotionCode)
-	}
+	state.FixedPromotionCode = syntax.ReconcileOptionalString(state.FixedPromotionCode, data.FixedPromotionCode)
 	state.Aid = types.StringValue(data.Aid)
 	state.TermDependencyType = types.StringValue(string(data.TermDependencyType))
-	state.StartDate = types.Int64Value(int64(data.StartDate))
+	state.StartDate = promotionDateFrom(state.StartDate, data.StartDate)
 	state.Name = types.StringValue(data.Name)
 	state.DiscountType = types.StringValue(string(data.DiscountType))
 	state.CreateDate = types.Int64Value(int64(data.CreateDate))
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.Status = types.StringValue(string(data.Status))
+	state.Uses = types.Int32Value(data.Uses)
+	state.setUnixTimeIso(r.dateLocation)
+	state.GeneratedCodeIds = types.ListValueMust(types.StringType, []attr.Value{})
+	if planned := state.GenerateCodes; planned != nil {
+		// The promotion is saved without generate_codes before generating codes
+		// so that it is not orphaned and the generation is retried when it fails.
+		state.GenerateCodes = nil
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		generated, ok := r.generatePromotionCodes(ctx, state.Aid.ValueString(), state.PromotionId.ValueString(), planned.Count.ValueInt64(), planned.Prefix, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		state.GenerateCodes = planned
+		state.GeneratedCodeIds = generated
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 func (r *PromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state PromotionResourceModel
+	var prior PromotionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -451,18 +551,11 @@ func (r *PromotionResource) Update(ctx context.Context, req resource.UpdateReque
 		NewCustomersOnly:         state.NewCustomersOnly.ValueBoolPointer(),
 		PromotionCodePrefix:      state.PromotionCodePrefix.ValueStringPointer(),
 	}
-	if state.UsesAllowed.IsNull() {
-		t := true
-		request.UnlimitedUses = &t
-	}
-	if state.StartDate.ValueInt64Pointer() != nil {
-		date := int(state.StartDate.ValueInt64())
-		request.StartDate = &date
-	}
-	if state.EndDate.ValueInt64Pointer() != nil {
-		date := int(state.EndDate.ValueInt64())
-		request.EndDate = &date
-	}
+	// unlimited_uses is reset explicitly so that switching back to limited uses takes effect
+	unlimitedUses := state.UsesAllowed.IsNull()
+	request.UnlimitedUses = &unlimitedUses
+	request.StartDate = promotionDateRequestFrom(state.StartDate, prior.StartDate)
+	request.EndDate = promotionDateRequestFrom(state.EndDate, prior.EndDate)
 	if state.PercentageDiscount.ValueFloat64Pointer() != nil {
 		discount := float32(state.PercentageDiscount.ValueFloat64())
 		request.PercentageDiscount = &discount
@@ -486,35 +579,217 @@ func (r *PromotionResource) Update(ctx context.Context, req resource.UpdateReque
 
 	data := result.Promotion
 	state.UsesAllowed = types.Int32PointerValue(data.UsesAllowed)
-	if state.PromotionCodePrefix.IsNull() && data.PromotionCodePrefix != nil && *data.PromotionCodePrefix == "" {
-		state.PromotionCodePrefix = types.StringNull()
-	} else {
-		state.PromotionCodePrefix = types.StringPointerValue(data.PromotionCodePrefix)
-	}
+	state.PromotionCodePrefix = syntax.ReconcileOptionalString(state.PromotionCodePrefix, data.PromotionCodePrefix)
 	state.PromotionId = types.StringValue(data.PromotionId)
 	state.UnlimitedUses = types.BoolValue(data.UnlimitedUses)
 	state.PercentageDiscount = types.Float64Value(data.PercentageDiscount)
 	state.NewCustomersOnly = types.BoolValue(data.NewCustomersOnly)
-	fixedDiscountListElements := []PromotionFixedDiscountResourceModel{}
-	for _, element := range data.FixedDiscountList {
-		fixedDiscountListElements = append(fixedDiscountListElements, PromotionFixedDiscountResourceModelFrom(element))
-	}
-	state.FixedDiscountList = fixedDiscountListElements
-	state.EndDate = types.Int64Value(int64(data.EndDate))
+	state.FixedDiscountList = PromotionFixedDiscountListResourceModelFrom(data.FixedDiscountList)
+	state.EndDate = promotionDateFrom(state.EndDate, data.EndDate)
 	state.NeverAllowZero = types.BoolValue(data.NeverAllowZero)
 	state.ApplyToAllBillingPeriods = types.BoolValue(data.ApplyToAllBillingPeriods)
 	state.CanBeAppliedOnRenewal = types.BoolValue(data.CanBeAppliedOnRenewal)
 	state.BillingPeriodLimit = types.Int32Value(data.BillingPeriodLimit)
-	state.FixedPromotionCode = types.StringPointerValue(data.FixedPromotionCode)
+	state.FixedPromotionCode = syntax.ReconcileOptionalString(state.FixedPromotionCode, data.FixedPromotionCode)
 	state.Aid = types.StringValue(data.Aid)
 	state.TermDependencyType = types.StringValue(string(data.TermDependencyType))
-	state.StartDate = types.Int64Value(int64(data.StartDate))
+	state.StartDate = promotionDateFrom(state.StartDate, data.StartDate)
 	state.Name = types.StringValue(data.Name)
 	state.DiscountType = types.StringValue(string(data.DiscountType))
 	state.CreateDate = types.Int64Value(int64(data.CreateDate))
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.Status = types.StringValue(string(data.Status))
+	state.Uses = types.Int32Value(data.Uses)
+	state.setUnixTimeIso(r.dateLocation)
+	state.GeneratedCodeIds = prior.GeneratedCodeIds
+	if state.GeneratedCodeIds.IsNull() || state.GeneratedCodeIds.IsUnknown() {
+		state.GeneratedCodeIds = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	existing := int64(len(state.GeneratedCodeIds.Elements()))
+	if planned := state.GenerateCodes; planned != nil && planned.Count.ValueInt64() > existing {
+		// The prior generate_codes is kept in state until the generation succeeds so that it is retried when it fails.
+		state.GenerateCodes = prior.GenerateCodes
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		generated, ok := r.generatePromotionCodes(ctx, state.Aid.ValueString(), state.PromotionId.ValueString(), planned.Count.ValueInt64()-existing, planned.Prefix, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		state.GenerateCodes = planned
+		state.GeneratedCodeIds = sortedStringList(append(state.GeneratedCodeIds.Elements(), generated.Elements()...))
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// promotionCodeClockSkew is the allowed difference between the clocks of this machine and piano.io API
+// when finding the codes created by a generation request.
+const promotionCodeClockSkew = time.Minute
+
+// generatePromotionCodes generates count promotion codes and returns the IDs of the codes it created sorted by ID.
+//
+// piano.io API does not return the generated codes, so they are found among the newest count codes of the promotion
+// that were created after the generation started.
+// Codes generated for the promotion by others at the same time cannot be told apart.
+func (r *PromotionResource) generatePromotionCodes(ctx context.Context, aid string, promotionId string, count int64, prefix types.String, diagnostics *diag.Diagnostics) (types.List, bool) {
+	since := time.Now().Add(-promotionCodeClockSkew).Unix()
+	response, err := r.client.PostPublisherPromotionGenerateWithFormdataBody(ctx, piano_publisher.PostPublisherPromotionGenerateFormdataRequestBody{
+		Aid:                 aid,
+		PromotionId:         promotionId,
+		Amount:              &count,
+		PromotionCodePrefix: prefix.ValueStringPointer(),
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate promotion codes, got error: %s", err))
+		return types.ListNull(types.StringType), false
+	}
+	if _, err := syntax.SuccessfulResponseFrom(response, diagnostics); err != nil {
+		return types.ListNull(types.StringType), false
+	}
+	orderBy := piano_publisher.GetPublisherPromotionCodeListParamsOrderByCreateDate
+	orderDirection := piano_publisher.GetPublisherPromotionCodeListParamsOrderDirectionDesc
+	promoCodes, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.PromoCode, int, error) {
+		response, err := r.client.GetPublisherPromotionCodeList(ctx, &piano_publisher.GetPublisherPromotionCodeListParams{
+			Aid:            aid,
+			PromotionId:    promotionId,
+			OrderBy:        &orderBy,
+			OrderDirection: &orderDirection,
+			Offset:         int32(offset),
+			Limit:          int32(min(int64(limit), count-int64(offset))),
+		})
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch promotion codes, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+		result := piano_publisher.PromoCodeArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		// Only the newest count codes are fetched, so count is reported as the total to stop pagination there.
+		return result.Data, int(count), nil
+	})
+	if err != nil {
+		return types.ListNull(types.StringType), false
+	}
+	generated := []attr.Value{}
+	for _, promoCode := range promoCodes {
+		if int64(len(generated)) < count && int64(promoCode.CreateDate) >= since {
+			generated = append(generated, types.StringValue(promoCode.PromoCodeId))
+		}
+	}
+	if int64(len(generated)) < count {
+		diagnostics.AddWarning(
+			"Missing Generated Promotion Codes",
+			fmt.Sprintf("Generated %d promotion codes for promotion %s, but found only %d of them. generated_code_ids does not list the missing codes.", count, promotionId, len(generated)),
+		)
+	}
+	return sortedStringList(generated), true
+}
+
+// sortedStringList returns a list of the strings sorted in ascending order.
+func sortedStringList(values []attr.Value) types.List {
+	sorted := append([]attr.Value{}, values...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].(types.String).ValueString() < sorted[j].(types.String).ValueString()
+	})
+	return types.ListValueMust(types.StringType, sorted)
+}
+
+// ModifyPlan plans start_date and end_date as null when they are removed from configuration.
+// Otherwise, UseStateForUnknown keeps the previous dates in plan and they can never be cleared.
+// It also plans generated_code_ids as unknown when generate_codes.count grows, as the additional codes are generated,
+// and rejects a count less than the number of codes already generated.
+// The `*_iso` attributes are planned from the planned dates.
+func (r *PromotionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		r.planUpdate(ctx, req, resp)
+	}
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "start_date", "end_date", "create_date", "update_date")
+}
+
+// planUpdate plans cleared dates and generated codes when updating an existing promotion.
+func (r *PromotionResource) planUpdate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	for _, attribute := range []string{"start_date", "end_date"} {
+		var config, state types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &config)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attribute), &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if config.IsNull() && !state.IsNull() && state.ValueInt64() != 0 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.Int64Null())...)
+		}
+	}
+	var planned *PromotionGenerateCodesResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("generate_codes"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planned == nil {
+		return
+	}
+	if planned.Count.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_code_ids"), types.ListUnknown(types.StringType))...)
+		return
+	}
+	var generatedCodeIds types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("generated_code_ids"), &generatedCodeIds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	existing := int64(len(generatedCodeIds.Elements()))
+	switch {
+	case planned.Count.ValueInt64() < existing:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("generate_codes").AtName("count"),
+			"Invalid Promotion Code Count",
+			fmt.Sprintf("count cannot be less than the %d promotion codes already generated by generate_codes, got %d. Generated codes are not deleted.", existing, planned.Count.ValueInt64()),
+		)
+	case planned.Count.ValueInt64() > existing:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("generated_code_ids"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// promotionDateRequestFrom returns the date to send to piano.io API.
+// piano.io API clears a date when it receives 0, so 0 is sent when the date is removed from configuration.
+func promotionDateRequestFrom(plan types.Int64, prior types.Int64) *int {
+	if plan.IsNull() || plan.IsUnknown() {
+		if prior.IsNull() || prior.IsUnknown() || prior.ValueInt64() == 0 {
+			return nil
+		}
+		cleared := 0
+		return &cleared
+	}
+	date := int(plan.ValueInt64())
+	return &date
+}
+
+// promotionDateFrom converts a date returned from piano.io API into terraform value.
+// piano.io API returns 0 for dates that are not set, which is kept as null when the date is not configured.
+func promotionDateFrom(plan types.Int64, apiValue int) types.Int64 {
+	if plan.IsNull() && apiValue == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(apiValue))
+}
+
+// promotionClaimedCodesErrorCode is the error code returned when deleting a promotion with claimed codes.
+const promotionClaimedCodesErrorCode = 3009
+
+// claimedPromotionCodesLimit is the maximum number of claimed codes shown in diagnostics.
+const claimedPromotionCodesLimit = 10
+
 func (r *PromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state PromotionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -530,11 +805,59 @@ func (r *PromotionResource) Delete(ctx context.Context, req resource.DeleteReque
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete licensee, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
-	// TODO: handle 3009 -- Can not delete promotion with claimed codes
+	anyResponse, err := syntax.AnyResponseFrom(response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
+	if errors.Is(anyResponse.Err(), syntax.ErrNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("Promotion %s has already been deleted", state.PromotionId.ValueString()))
+		return
+	}
+	if anyResponse.Code == promotionClaimedCodesErrorCode {
+		detail := fmt.Sprintf("Promotion %s cannot be deleted because some of its promotion codes have been claimed.", state.PromotionId.ValueString())
+		if codes := r.claimedPromotionCodes(ctx, state.Aid.ValueString(), state.PromotionId.ValueString()); len(codes) > 0 {
+			detail += fmt.Sprintf(" Claimed codes include: %s.", strings.Join(codes, ", "))
+		}
+		detail += " Delete the claimed codes in piano.io dashboard before destroying this resource, " +
+			"or stop managing the promotion with `terraform state rm` and set `end_date` to stop it from being applied to new purchases."
+		resp.Diagnostics.AddError("Promotion Has Claimed Codes", detail)
+		return
+	}
+	if anyResponse.Code != 0 {
+		syntax.AddStatusError(anyResponse, &resp.Diagnostics)
+		return
+	}
+}
+
+// claimedPromotionCodes lists up to claimedPromotionCodesLimit codes of the promotion that have been used.
+// It is best effort: failures are only logged as it is called to enrich another error.
+func (r *PromotionResource) claimedPromotionCodes(ctx context.Context, aid string, promotionId string) []string {
+	response, err := r.client.GetPublisherPromotionCodeList(ctx, &piano_publisher.GetPublisherPromotionCodeListParams{
+		Aid:         aid,
+		PromotionId: promotionId,
+		State:       &[]piano_publisher.GetPublisherPromotionCodeListParamsState{piano_publisher.GetPublisherPromotionCodeListParamsStateUsed},
+		Limit:       claimedPromotionCodesLimit,
+	})
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to list claimed codes of promotion %s, got error: %s", promotionId, err))
+		return nil
+	}
+	anyResponse, err := piano.SuccessfulResponseFrom(response, func(summary, detail string) {
+		tflog.Warn(ctx, fmt.Sprintf("unable to list claimed codes of promotion %s: %s: %s", promotionId, summary, detail))
+	})
+	if err != nil {
+		return nil
+	}
+	result := piano_publisher.PromoCodeArrayResult{}
+	if err := json.Unmarshal(anyResponse.Raw, &result); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to decode claimed codes of promotion %s, got error: %s", promotionId, err))
+		return nil
+	}
+	codes := []string{}
+	for _, code := range result.Data {
+		codes = append(codes, code.Code)
+	}
+	return codes
 }
 func (*PromotionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	promotionId, err := PromotionIdFromString(req.ID)
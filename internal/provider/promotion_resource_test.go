@@ -0,0 +1,439 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPromotionFixedDiscountListResourceModelFrom_Stable(t *testing.T) {
+	usd := piano_publisher.PromotionFixedDiscount{FixedDiscountId: "FD1", Currency: "USD", Amount: "$1.00", AmountValue: 1}
+	eur := piano_publisher.PromotionFixedDiscount{FixedDiscountId: "FD2", Currency: "EUR", Amount: "€1.00", AmountValue: 1}
+	jpy := piano_publisher.PromotionFixedDiscount{FixedDiscountId: "FD3", Currency: "JPY", Amount: "¥100", AmountValue: 100}
+
+	expected := PromotionFixedDiscountListResourceModelFrom([]piano_publisher.PromotionFixedDiscount{usd, eur, jpy})
+	actual := PromotionFixedDiscountListResourceModelFrom([]piano_publisher.PromotionFixedDiscount{jpy, usd, eur})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected reordered fixed_discount_list to produce no diff, got %v and %v", expected, actual)
+	}
+	currencies := []string{}
+	for _, element := range actual {
+		currencies = append(currencies, element.Currency.ValueString())
+	}
+	if !reflect.DeepEqual(currencies, []string{"EUR", "JPY", "USD"}) {
+		t.Errorf("expected fixed_discount_list to be sorted by currency, got %v", currencies)
+	}
+}
+
+func TestPromotionResourceUpdateClearsDates(t *testing.T) {
+	cases := []struct {
+		name              string
+		plannedStartDate  types.Int64
+		expectedStartDate string
+		expected          types.Int64
+	}{
+		{name: "start_date removed from configuration", plannedStartDate: types.Int64Null(), expectedStartDate: "0", expected: types.Int64Null()},
+		{name: "start_date kept in configuration", plannedStartDate: types.Int64Value(1735657200), expectedStartDate: "1735657200", expected: types.Int64Value(1735657200)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/promotion/update" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				if err := req.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				if actual := req.PostForm.Get("start_date"); actual != c.expectedStartDate {
+					t.Errorf("expected start_date %s, got %s", c.expectedStartDate, actual)
+				}
+				if _, ok := req.PostForm["end_date"]; ok {
+					t.Errorf("expected end_date not to be sent, got %v", req.PostForm)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"code":0,"promotion":{"aid":"example","promotion_id":"PROMO1","name":"Spring","start_date":%s,"end_date":0,"discount_type":"percentage","term_dependency_type":"all"}}`, c.expectedStartDate)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &PromotionResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			prior := PromotionResourceModel{
+				Aid:                types.StringValue("example"),
+				PromotionId:        types.StringValue("PROMO1"),
+				Name:               types.StringValue("Spring"),
+				StartDate:          types.Int64Value(1735657200),
+				EndDate:            types.Int64Null(),
+				DiscountType:       types.StringValue("percentage"),
+				TermDependencyType: types.StringValue("all"),
+				FixedDiscountList:  []PromotionFixedDiscountResourceModel{},
+				GeneratedCodeIds:   types.ListNull(types.StringType),
+			}
+			state := newTestState(t, schemaResp.Schema, &prior)
+			planned := prior
+			planned.StartDate = c.plannedStartDate
+			plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual PromotionResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !actual.StartDate.Equal(c.expected) {
+				t.Errorf("expected start_date %s, got %s", c.expected, actual.StartDate)
+			}
+			if !actual.EndDate.IsNull() {
+				t.Errorf("expected end_date to stay null, got %s", actual.EndDate)
+			}
+		})
+	}
+}
+
+func TestPromotionResourceUpdateUsesAllowed(t *testing.T) {
+	cases := []struct {
+		name                  string
+		priorUsesAllowed      types.Int32
+		plannedUsesAllowed    types.Int32
+		expectedUnlimitedUses string
+		expectedUsesAllowed   string
+		response              string
+	}{
+		{
+			name:                  "limited to unlimited",
+			priorUsesAllowed:      types.Int32Value(100),
+			plannedUsesAllowed:    types.Int32Null(),
+			expectedUnlimitedUses: "true",
+			expectedUsesAllowed:   "",
+			response:              `"unlimited_uses":true`,
+		},
+		{
+			name:                  "unlimited to limited",
+			priorUsesAllowed:      types.Int32Null(),
+			plannedUsesAllowed:    types.Int32Value(100),
+			expectedUnlimitedUses: "false",
+			expectedUsesAllowed:   "100",
+			response:              `"unlimited_uses":false,"uses_allowed":100`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if err := req.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				if actual := req.PostForm.Get("unlimited_uses"); actual != c.expectedUnlimitedUses {
+					t.Errorf("expected unlimited_uses %s, got %s", c.expectedUnlimitedUses, actual)
+				}
+				if actual := req.PostForm.Get("uses_allowed"); actual != c.expectedUsesAllowed {
+					t.Errorf("expected uses_allowed %s, got %s", c.expectedUsesAllowed, actual)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"code":0,"promotion":{"aid":"example","promotion_id":"PROMO1","name":"Spring","discount_type":"percentage","term_dependency_type":"all",%s}}`, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &PromotionResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			prior := PromotionResourceModel{
+				Aid:                types.StringValue("example"),
+				PromotionId:        types.StringValue("PROMO1"),
+				Name:               types.StringValue("Spring"),
+				DiscountType:       types.StringValue("percentage"),
+				TermDependencyType: types.StringValue("all"),
+				UsesAllowed:        c.priorUsesAllowed,
+				UnlimitedUses:      types.BoolValue(c.priorUsesAllowed.IsNull()),
+				FixedDiscountList:  []PromotionFixedDiscountResourceModel{},
+				GeneratedCodeIds:   types.ListNull(types.StringType),
+			}
+			state := newTestState(t, schemaResp.Schema, &prior)
+			planned := prior
+			planned.UsesAllowed = c.plannedUsesAllowed
+			planned.UnlimitedUses = types.BoolUnknown()
+			plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual PromotionResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !actual.UsesAllowed.Equal(c.plannedUsesAllowed) {
+				t.Errorf("expected uses_allowed %s, got %s", c.plannedUsesAllowed, actual.UsesAllowed)
+			}
+			if actual.UnlimitedUses.ValueBool() != c.plannedUsesAllowed.IsNull() {
+				t.Errorf("expected unlimited_uses %t, got %s", c.plannedUsesAllowed.IsNull(), actual.UnlimitedUses)
+			}
+		})
+	}
+}
+
+func TestPromotionResourcePercentageDiscountBounds(t *testing.T) {
+	ctx := context.Background()
+	r := &PromotionResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	attribute := schemaResp.Schema.Attributes["percentage_discount"].(schema.Float64Attribute)
+
+	cases := []struct {
+		value   float64
+		wantErr bool
+	}{
+		{value: 0, wantErr: false},
+		{value: 100, wantErr: false},
+		{value: 100.1, wantErr: true},
+		{value: -1, wantErr: true},
+	}
+	for _, c := range cases {
+		req := validator.Float64Request{
+			Path:        path.Root("percentage_discount"),
+			ConfigValue: types.Float64Value(c.value),
+		}
+		resp := validator.Float64Response{}
+		for _, v := range attribute.Validators {
+			v.ValidateFloat64(ctx, req, &resp)
+		}
+		if resp.Diagnostics.HasError() != c.wantErr {
+			t.Errorf("percentage_discount %v: expected error %t, got %v", c.value, c.wantErr, resp.Diagnostics)
+			continue
+		}
+		for _, d := range resp.Diagnostics {
+			if !strings.Contains(d.Detail(), "percentage_discount") || !strings.Contains(d.Detail(), "between 0") {
+				t.Errorf("percentage_discount %v: expected the attribute and the allowed range in %q", c.value, d.Detail())
+			}
+		}
+	}
+}
+
+func TestPromotionResourceDeleteWithClaimedCodes(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/publisher/promotion/delete":
+			fmt.Fprint(w, `{"code":3009,"message":"Can not delete promotion with claimed codes"}`)
+		case "/publisher/promotion/code/list":
+			if actual := req.URL.Query().Get("state"); actual != "used" {
+				t.Errorf("expected used codes to be listed, got state %s", actual)
+			}
+			fmt.Fprint(w, `{"code":0,"data":[{"promo_code_id":"PC1","promotion_id":"PROMO1","code":"SPRING-1","state":"used"},{"promo_code_id":"PC2","promotion_id":"PROMO1","code":"SPRING-2","state":"used"}]}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &PromotionResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := newTestState(t, schemaResp.Schema, &PromotionResourceModel{
+		Aid:               types.StringValue("example"),
+		PromotionId:       types.StringValue("PROMO1"),
+		Name:              types.StringValue("Spring"),
+		FixedDiscountList: []PromotionFixedDiscountResourceModel{},
+		GeneratedCodeIds:  types.ListNull(types.StringType),
+	})
+
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected an error, got %v", resp.Diagnostics)
+	}
+	d := resp.Diagnostics.Errors()[0]
+	if d.Summary() != "Promotion Has Claimed Codes" {
+		t.Errorf("unexpected summary: %s", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), "SPRING-1, SPRING-2") {
+		t.Errorf("expected claimed codes in the detail, got %s", d.Detail())
+	}
+}
+
+func TestPromotionResourceUpdateGenerateCodes(t *testing.T) {
+	cases := []struct {
+		name             string
+		plannedCount     int64
+		generateCode     int
+		expectGeneration bool
+		expectedError    bool
+		expectedCount    int64
+		expected         []string
+	}{
+		{name: "generate_codes unchanged", plannedCount: 1, expectGeneration: false, expectedCount: 1, expected: []string{"PC1"}},
+		{name: "generate_codes count increased", plannedCount: 2, expectGeneration: true, expectedCount: 2, expected: []string{"PC1", "PC3"}},
+		{name: "generation failed", plannedCount: 2, generateCode: 2, expectGeneration: true, expectedError: true, expectedCount: 1, expected: []string{"PC1"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			generated := false
+			now := time.Now().Unix()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch req.URL.Path {
+				case "/publisher/promotion/update":
+					fmt.Fprint(w, `{"code":0,"promotion":{"aid":"example","promotion_id":"PROMO1","name":"Spring","discount_type":"percentage","term_dependency_type":"all","status":"active","uses":3}}`)
+				case "/publisher/promotion/generate":
+					if err := req.ParseForm(); err != nil {
+						t.Fatal(err)
+					}
+					// Only the additional code is generated.
+					if actual := req.PostForm.Get("amount"); actual != "1" {
+						t.Errorf("expected amount 1, got %s", actual)
+					}
+					if actual := req.PostForm.Get("promotion_code_prefix"); actual != "SPRING" {
+						t.Errorf("expected promotion_code_prefix SPRING, got %s", actual)
+					}
+					generated = true
+					fmt.Fprintf(w, `{"code":%d,"message":"failed","promotion":{"aid":"example","promotion_id":"PROMO1"}}`, c.generateCode)
+				case "/publisher/promotion/code/list":
+					query := req.URL.Query()
+					if query.Get("order_by") != "create_date" || query.Get("order_direction") != "desc" || query.Get("limit") != "1" {
+						t.Errorf("expected only the newest code to be listed, got %s", req.URL.RawQuery)
+					}
+					fmt.Fprintf(w, `{"code":0,"total":3,"data":[{"promo_code_id":"PC3","code":"SPRING-3","create_date":%d}]}`, now)
+				default:
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &PromotionResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			prior := PromotionResourceModel{
+				Aid:                types.StringValue("example"),
+				PromotionId:        types.StringValue("PROMO1"),
+				Name:               types.StringValue("Spring"),
+				DiscountType:       types.StringValue("percentage"),
+				TermDependencyType: types.StringValue("all"),
+				FixedDiscountList:  []PromotionFixedDiscountResourceModel{},
+				GenerateCodes:      &PromotionGenerateCodesResourceModel{Count: types.Int64Value(1), Prefix: types.StringValue("SPRING")},
+				GeneratedCodeIds:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("PC1")}),
+			}
+			state := newTestState(t, schemaResp.Schema, &prior)
+			planned := prior
+			planned.GenerateCodes = &PromotionGenerateCodesResourceModel{Count: types.Int64Value(c.plannedCount), Prefix: types.StringValue("SPRING")}
+			plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Fatalf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if generated != c.expectGeneration {
+				t.Errorf("expected generation %t, got %t", c.expectGeneration, generated)
+			}
+			var actual PromotionResourceModel
+			diags := resp.State.Get(ctx, &actual)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if actual.Status.ValueString() != "active" || actual.Uses.ValueInt32() != 3 {
+				t.Errorf("expected status active and uses 3, got %s and %s", actual.Status, actual.Uses)
+			}
+			// The prior generate_codes is kept when the generation fails so that it is retried.
+			if actual.GenerateCodes.Count.ValueInt64() != c.expectedCount {
+				t.Errorf("expected generate_codes.count %d, got %s", c.expectedCount, actual.GenerateCodes.Count)
+			}
+			ids := []string{}
+			diags.Append(actual.GeneratedCodeIds.ElementsAs(ctx, &ids, false)...)
+			if !reflect.DeepEqual(ids, c.expected) {
+				t.Errorf("expected generated_code_ids %v, got %v", c.expected, ids)
+			}
+		})
+	}
+}
+
+func TestPromotionResourceModifyPlanGenerateCodes(t *testing.T) {
+	cases := []struct {
+		name          string
+		plannedCount  types.Int64
+		expectedError bool
+		expectUnknown bool
+	}{
+		{name: "unchanged", plannedCount: types.Int64Value(2)},
+		{name: "increased", plannedCount: types.Int64Value(3), expectUnknown: true},
+		{name: "unknown", plannedCount: types.Int64Unknown(), expectUnknown: true},
+		{name: "decreased", plannedCount: types.Int64Value(1), expectedError: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &PromotionResource{}
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			prior := PromotionResourceModel{
+				Aid:                types.StringValue("example"),
+				PromotionId:        types.StringValue("PROMO1"),
+				Name:               types.StringValue("Spring"),
+				DiscountType:       types.StringValue("percentage"),
+				TermDependencyType: types.StringValue("all"),
+				FixedDiscountList:  []PromotionFixedDiscountResourceModel{},
+				GenerateCodes:      &PromotionGenerateCodesResourceModel{Count: types.Int64Value(2), Prefix: types.StringNull()},
+				GeneratedCodeIds:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("PC1"), types.StringValue("PC2")}),
+			}
+			state := newTestState(t, schemaResp.Schema, &prior)
+			planned := prior
+			planned.GenerateCodes = &PromotionGenerateCodesResourceModel{Count: c.plannedCount, Prefix: types.StringNull()}
+			plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}, Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Fatalf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if c.expectedError {
+				return
+			}
+			var generatedCodeIds types.List
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("generated_code_ids"), &generatedCodeIds)...)
+			if generatedCodeIds.IsUnknown() != c.expectUnknown {
+				t.Errorf("expected generated_code_ids to be unknown: %t, got %s", c.expectUnknown, generatedCodeIds)
+			}
+		})
+	}
+}
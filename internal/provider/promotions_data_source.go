@@ -0,0 +1,270 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &PromotionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &PromotionsDataSource{}
+)
+
+// PromotionsDataSource defines the data source implementation.
+type PromotionsDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+func NewPromotionsDataSource() datasource.DataSource {
+	return &PromotionsDataSource{}
+}
+
+// PromotionsDataSourceModel describes the data source data model.
+type PromotionsDataSourceModel struct {
+	Aid        types.String               `tfsdk:"aid"`        // The application ID
+	State      types.String               `tfsdk:"state"`      // The promotion state to filter by
+	Promotions []PromotionDataSourceModel `tfsdk:"promotions"` // The promotions of the application
+}
+
+func (r *PromotionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+}
+func (r *PromotionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_promotions"
+}
+
+func (*PromotionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Promotions data source. This data source is used to list promotions of an application.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The promotion status to filter by. All the promotions are listed when this value is null.",
+				Validators:          []validator.String{stringvalidator.OneOf("active", "expired", "new")},
+			},
+			"promotions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The promotions of the application",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"discount_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion discount type",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion name",
+						},
+						"start_date": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The start date.",
+						},
+						"term_dependency_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of dependency to terms",
+						},
+						"create_date": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The creation date",
+						},
+						"deleted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the object is deleted",
+						},
+						"update_by": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The last user to update the object",
+						},
+						"aid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The application ID",
+						},
+						"fixed_promotion_code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fixed value for all the promotion codes",
+						},
+						"uses": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "How many times the promotion has been used",
+						},
+						"billing_period_limit": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "Promotion discount applies to number of billing periods",
+						},
+						"can_be_applied_on_renewal": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the promotion can be applied on renewal",
+						},
+						"discount_currency": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion discount currency",
+						},
+						"update_date": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The update date",
+						},
+						"apply_to_all_billing_periods": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether to apply the promotion discount to all billing periods (\"TRUE\")or the first billing period only (\"FALSE\")",
+						},
+						"never_allow_zero": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Never allow the value of checkout to be zero",
+						},
+						"end_date": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The end date",
+						},
+						"fixed_discount_list": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"fixed_discount_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The fixed discount ID",
+									},
+									"currency": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The currency of the fixed discount",
+									},
+									"amount": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The fixed discount amount",
+									},
+									"amount_value": schema.Float64Attribute{
+										Computed:            true,
+										MarkdownDescription: "The fixed discount amount value",
+									},
+								},
+							},
+						},
+						"new_customers_only": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the promotion allows new customers only",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion status",
+						},
+						"percentage_discount": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion discount, percentage",
+						},
+						"unlimited_uses": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether to allow unlimited uses",
+						},
+						"discount_amount": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion discount",
+						},
+						"promotion_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion ID",
+						},
+						"promotion_code_prefix": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prefix for all the codes",
+						},
+						"create_by": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user who created the object",
+						},
+						"uses_allowed": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of uses allowed by the promotion",
+						},
+						"discount": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The promotion discount, formatted",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// promotionListExpiredFrom maps the promotion status to the `expired` filter of the promotion list endpoint.
+func promotionListExpiredFrom(state types.String) piano_publisher.GetPublisherPromotionListParamsExpired {
+	switch state.ValueString() {
+	case string(piano_publisher.PromotionStatusActive):
+		return piano_publisher.GetPublisherPromotionListParamsExpiredActive
+	case string(piano_publisher.PromotionStatusExpired):
+		return piano_publisher.GetPublisherPromotionListParamsExpiredExpired
+	case string(piano_publisher.PromotionStatusNew):
+		return piano_publisher.GetPublisherPromotionListParamsExpiredNotStarted
+	default:
+		return piano_publisher.GetPublisherPromotionListParamsExpiredAll
+	}
+}
+
+func (r *PromotionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state PromotionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expired := promotionListExpiredFrom(state.State)
+	data, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.Promotion, int, error) {
+		params := piano_publisher.GetPublisherPromotionListParams{
+			Aid:     state.Aid.ValueString(),
+			Expired: &expired,
+			Offset:  int32(offset),
+			Limit:   int32(limit),
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching promotions in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := r.client.GetPublisherPromotionList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch promotions, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result := piano_publisher.PromotionArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.Promotions, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return
+	}
+	promotions := []PromotionDataSourceModel{}
+	for _, promotion := range data {
+		promotions = append(promotions, PromotionDataSourceModelFrom(promotion))
+	}
+
+	state.Promotions = promotions
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
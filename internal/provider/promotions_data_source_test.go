@@ -0,0 +1,123 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPromotionsDataSourceRead(t *testing.T) {
+	// 130 promotions over two pages, where the first one has a fixed discount.
+	const total = 130
+	cases := []struct {
+		name            string
+		state           types.String
+		expectedExpired string
+	}{
+		{name: "all", state: types.StringNull(), expectedExpired: "all"},
+		{name: "active", state: types.StringValue("active"), expectedExpired: "active"},
+		{name: "new", state: types.StringValue("new"), expectedExpired: "not_started"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/promotion/list" {
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+				query := req.URL.Query()
+				if query.Get("aid") != "example" || query.Get("expired") != c.expectedExpired {
+					t.Errorf("expected aid example and expired %s, got %s", c.expectedExpired, req.URL.RawQuery)
+				}
+				offset, _ := strconv.Atoi(query.Get("offset"))
+				limit, _ := strconv.Atoi(query.Get("limit"))
+				promotions := []map[string]any{}
+				for i := offset; i < min(offset+limit, total); i++ {
+					promotion := map[string]any{"aid": "example", "promotion_id": fmt.Sprintf("PM%d", i), "name": fmt.Sprintf("Promotion %d", i), "status": "active", "discount_type": "percentage"}
+					if i == 0 {
+						promotion["discount_type"] = "fixed"
+						promotion["uses_allowed"] = 10
+						promotion["fixed_discount_list"] = []map[string]any{{"fixed_discount_id": "FD1", "currency": "USD", "amount": "$1.00", "amount_value": 1}}
+					}
+					promotions = append(promotions, promotion)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "promotions": promotions, "total": total}); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &PromotionsDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &PromotionsDataSourceModel{
+				Aid:   types.StringValue("example"),
+				State: c.state,
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual PromotionsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if len(actual.Promotions) != total {
+				t.Fatalf("expected %d promotions, got %d", total, len(actual.Promotions))
+			}
+			first := actual.Promotions[0]
+			if first.PromotionId.ValueString() != "PM0" || first.DiscountType.ValueString() != "fixed" || first.UsesAllowed.ValueInt32() != 10 {
+				t.Errorf("unexpected first promotion: %v", first)
+			}
+			expectedFixedDiscounts := []PromotionFixedDiscountDataSourceModel{{
+				FixedDiscountId: types.StringValue("FD1"),
+				Currency:        types.StringValue("USD"),
+				Amount:          types.StringValue("$1.00"),
+				AmountValue:     types.Float64Value(1),
+			}}
+			if !reflect.DeepEqual(first.FixedDiscountList, expectedFixedDiscounts) {
+				t.Errorf("expected fixed discounts %v, got %v", expectedFixedDiscounts, first.FixedDiscountList)
+			}
+			if last := actual.Promotions[total-1]; last.PromotionId.ValueString() != "PM129" || !last.UsesAllowed.IsNull() || len(last.FixedDiscountList) != 0 {
+				t.Errorf("unexpected last promotion: %v", last)
+			}
+		})
+	}
+}
+
+func TestPromotionListExpiredFrom(t *testing.T) {
+	cases := []struct {
+		state    types.String
+		expected piano_publisher.GetPublisherPromotionListParamsExpired
+	}{
+		{state: types.StringNull(), expected: piano_publisher.GetPublisherPromotionListParamsExpiredAll},
+		{state: types.StringValue("active"), expected: piano_publisher.GetPublisherPromotionListParamsExpiredActive},
+		{state: types.StringValue("expired"), expected: piano_publisher.GetPublisherPromotionListParamsExpiredExpired},
+		{state: types.StringValue("new"), expected: piano_publisher.GetPublisherPromotionListParamsExpiredNotStarted},
+	}
+	for _, c := range cases {
+		if actual := promotionListExpiredFrom(c.state); actual != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.state, c.expected, actual)
+		}
+	}
+}
@@ -7,18 +7,24 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"terraform-provider-piano/internal/piano_id"
 	"terraform-provider-piano/internal/piano_publisher"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,6 +33,7 @@ import (
 var _ provider.Provider = &PianoProvider{}
 var _ provider.ProviderWithFunctions = &PianoProvider{}
 var _ provider.ProviderWithEphemeralResources = &PianoProvider{}
+var _ provider.ProviderWithConfigValidators = &PianoProvider{}
 
 // PianoProvider defines the provider implementation for piano.io resources.
 type PianoProvider struct {
@@ -41,11 +48,70 @@ type PianoProviderModel struct {
 	Endpoint types.String `tfsdk:"endpoint"`
 	ApiToken types.String `tfsdk:"api_token"`
 	AppId    types.String `tfsdk:"app_id"`
+	// ClientId and ClientSecret are OAuth client credentials used instead of ApiToken
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	// TokenUrl is the OAuth token endpoint
+	TokenUrl types.String `tfsdk:"token_url"`
+	// DebugHttp enables logging HTTP requests and responses exchanged with piano.io API
+	DebugHttp types.Bool `tfsdk:"debug_http"`
+	// InsecureLogSensitive disables redaction of sensitive values in HTTP debug logs
+	InsecureLogSensitive types.Bool `tfsdk:"insecure_log_sensitive"`
+	// DateTimezone is the IANA time zone name used to format dates
+	DateTimezone types.String `tfsdk:"date_timezone"`
+	// MaxRetries is the number of retries when piano.io API reports an object just created as not found
+	MaxRetries types.Int32 `tfsdk:"max_retries"`
+	// RateLimit is the maximum number of requests per second sent to piano.io API
+	RateLimit types.Int32 `tfsdk:"rate_limit"`
+	// InsecureSkipVerify disables the verification of TLS certificates of piano.io API
+	InsecureSkipVerify types.Bool `tfsdk:"insecure_skip_verify"`
+	// CaBundle is the path to a PEM file of CA certificates trusted in addition to the system roots
+	CaBundle types.String `tfsdk:"ca_bundle"`
+	// ProxyUrl is the URL of the proxy requests to piano.io API are sent through
+	ProxyUrl types.String `tfsdk:"proxy_url"`
 }
 
 type PianoProviderData struct {
 	publisherClient piano_publisher.Client
 	idClient        piano_id.Client
+	// dateLocation is the location used to format dates. See syntax.FormatDate.
+	dateLocation *time.Location
+	// defaultAid is the aid of resources which do not set aid. See planDefaultAid.
+	defaultAid types.String
+	// maxRetries is the number of retries when an object just created is not found. See retryUntilFound.
+	maxRetries int
+}
+
+// configureClients extracts the clients passed from the provider to resources and data sources in their Configure.
+// It returns nil without diagnostics when the provider has not been configured yet.
+func configureClients(providerData any) (*PianoProviderData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if providerData == nil {
+		return nil, diags
+	}
+	data, ok := providerData.(*PianoProviderData)
+	if !ok {
+		diags.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected *PianoProviderData, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil, diags
+	}
+	return data, diags
+}
+
+// AidMatches reports whether the application ID in state matches the one of the object fetched from piano.io API.
+// A mismatch usually comes from a wrong import ID, so it is reported as an error instead of being overwritten silently.
+func AidMatches(stateAid types.String, actualAid string, diagnostics *diag.Diagnostics) bool {
+	if stateAid.IsNull() || stateAid.IsUnknown() || stateAid.ValueString() == actualAid {
+		return true
+	}
+	diagnostics.AddAttributeError(
+		path.Root("aid"),
+		"Application ID Mismatch",
+		fmt.Sprintf("The object belongs to application %s, but aid is %s. Make sure the import ID contains the correct application ID.", actualAid, stateAid.ValueString()),
+	)
+	return false
 }
 
 func (p *PianoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -62,17 +128,92 @@ func (p *PianoProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Required:            true,
 			},
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "API Token for piano.io API",
-				Required:            true,
+				MarkdownDescription: "API Token for piano.io API. The token is redacted from diagnostics and logs. " +
+					"Defaults to `PIANO_API_TOKEN` environment variable. Conflicts with `client_id`.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth client ID. When set, the provider obtains access tokens with the client credentials grant and uses them instead of `api_token`.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "OAuth client secret. Required together with `client_id`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "OAuth token endpoint used with `client_id`. Defaults to `/id/api/v1/identity/oauth/token` on the host of `endpoint`.",
+				Optional:            true,
 			},
 			"app_id": schema.StringAttribute{
-				MarkdownDescription: "App Id for piano.io API",
-				Required:            true,
+				MarkdownDescription: "App Id for piano.io API. It is also the default `aid` of resources which do not set `aid`. " +
+					"Defaults to `PIANO_APP_ID` environment variable.",
+				Optional:   true,
+				Validators: aidValidators(),
+			},
+			"debug_http": schema.BoolAttribute{
+				MarkdownDescription: "Log HTTP requests and responses exchanged with piano.io API at DEBUG level. " +
+					"Sensitive values such as API token are redacted. Defaults to `false`.",
+				Optional: true,
+			},
+			"insecure_log_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "**INSECURE. DO NOT USE IN PRODUCTION.** Stop redacting sensitive values such as API token in HTTP debug logs. " +
+					"This only takes effect when `debug_http` is `true`. Defaults to `false`.",
+				Optional: true,
+			},
+			"date_timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA time zone name such as `Asia/Tokyo` used to format dates in RFC3339, i.e. the `*_iso` attributes of resources. Defaults to `UTC`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int32Attribute{
+				MarkdownDescription: "The number of retries with exponential backoff when piano.io API reports an object just created as not found " +
+					"due to eventual consistency. Defaults to `5`.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"rate_limit": schema.Int32Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to piano.io API. Requests beyond this rate wait for their turn, " +
+					"which smooths out bursts of requests when applying many resources at once. Requests are not limited when this value is null.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "**INSECURE. DO NOT USE IN PRODUCTION.** Skip the verification of TLS certificates of piano.io API, " +
+					"e.g. for a local proxy or sandbox with a self-signed certificate. Prefer `ca_bundle` whenever the CA certificate is available. Defaults to `false`.",
+				Optional: true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of CA certificates trusted in addition to the system roots, " +
+					"e.g. the certificate of an inspecting proxy between the provider and piano.io API.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy such as `http://proxy.example.com:3128` that requests to piano.io API are sent through. " +
+					"`http`, `https` and `socks5` schemes are supported. When set, `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables are ignored.",
+				Optional: true,
 			},
 		},
 	}
 }
 
+func (p *PianoProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("api_token"),
+			path.MatchRoot("client_id"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_id"),
+			path.MatchRoot("client_secret"),
+		),
+	}
+}
+
 func (p *PianoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring piano client")
 	var config PianoProviderModel
@@ -92,6 +233,34 @@ func (p *PianoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	dateLocation := time.UTC
+	if !config.DateTimezone.IsNull() {
+		location, err := time.LoadLocation(config.DateTimezone.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("date_timezone"),
+				"Invalid date_timezone",
+				fmt.Sprintf("date_timezone must be an IANA time zone name, got error: %s", err),
+			)
+		} else {
+			dateLocation = location
+		}
+	}
+
+	var proxyUrl *url.URL
+	if !config.ProxyUrl.IsNull() {
+		parsed, err := parseProxyUrl(config.ProxyUrl.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid proxy_url",
+				fmt.Sprintf("proxy_url must be a URL such as http://proxy.example.com:3128, got error: %s", err),
+			)
+		} else {
+			proxyUrl = parsed
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -105,40 +274,145 @@ func (p *PianoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.ApiToken.IsNull() {
 		apiToken = config.ApiToken.ValueString()
 	}
+	if !config.AppId.IsNull() {
+		appId = config.AppId.ValueString()
+	}
+	defaultAid := types.StringValue(appId)
+	if config.AppId.IsUnknown() {
+		defaultAid = types.StringUnknown()
+	}
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt32())
+	}
+
+	baseTransport := http.DefaultTransport.(*http.Transport)
+	if config.InsecureSkipVerify.ValueBool() || !config.CaBundle.IsNull() {
+		tlsTransport, err := newTlsHttpTransport(config.InsecureSkipVerify.ValueBool(), config.CaBundle.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_bundle"),
+				"Invalid ca_bundle",
+				fmt.Sprintf("Unable to load CA certificates from %s, got error: %s", config.CaBundle.ValueString(), err),
+			)
+			return
+		}
+		baseTransport = tlsTransport
+	}
+	if proxyUrl != nil {
+		baseTransport = withProxyUrl(baseTransport, proxyUrl)
+	}
+	var transport http.RoundTripper = baseTransport
+	if config.InsecureSkipVerify.ValueBool() {
+		tflog.Warn(ctx, "insecure_skip_verify is enabled: TLS certificates of piano.io API are not verified")
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificates are not verified",
+			"insecure_skip_verify is enabled. The provider does not verify TLS certificates of piano.io API, so API token and other data can be intercepted. "+
+				"Never enable this option in production and use ca_bundle to trust a proxy certificate instead.",
+		)
+	}
+
+	var tokenSource *clientCredentialsTokenSource
+	if !config.ClientId.IsNull() {
+		tokenURL := fmt.Sprintf("%s/id/api/v1/identity/oauth/token", strings.TrimSuffix(endpoint, "/api/v3"))
+		if !config.TokenUrl.IsNull() {
+			tokenURL = config.TokenUrl.ValueString()
+		}
+		// The token endpoint is not called through debugHttpTransport to keep client_secret and access tokens out of logs.
+		tokenSource = newClientCredentialsTokenSource(tokenURL, config.ClientId.ValueString(), config.ClientSecret.ValueString(), &http.Client{Transport: transport})
+		apiToken = ""
+	} else if apiToken == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Missing piano API credentials",
+			"Set either api_token (or PIANO_API_TOKEN environment variable) or client_id and client_secret.",
+		)
+		return
+	}
 
 	tflog.SetField(ctx, "piano_endpoint", endpoint)
 	tflog.SetField(ctx, "piano_api_token", apiToken)
 	tflog.SetField(ctx, "piano_app_id", appId)
 	idEndpoint := fmt.Sprintf("%s/id/api/v1", strings.TrimSuffix(endpoint, "/api/v3"))
 	tflog.MaskFieldValuesWithFieldKeys(ctx, "piano_api_token")
-	idClient, err := piano_id.NewClient(idEndpoint, func(client *piano_id.Client) error {
+	if config.DebugHttp.ValueBool() {
+		insecureLogSensitive := config.InsecureLogSensitive.ValueBool()
+		if insecureLogSensitive {
+			tflog.Warn(ctx, "insecure_log_sensitive is enabled: API token and other sensitive values are written to logs as is")
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("insecure_log_sensitive"),
+				"Sensitive values are logged",
+				"insecure_log_sensitive is enabled. API token and other sensitive values are written to HTTP debug logs without redaction. "+
+					"Never enable this option in production and rotate the API token if the logs may have been shared.",
+			)
+		}
+		transport = &debugHttpTransport{
+			transport:            transport,
+			insecureLogSensitive: insecureLogSensitive,
+		}
+	} else if config.InsecureLogSensitive.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_log_sensitive"),
+			"insecure_log_sensitive has no effect",
+			"insecure_log_sensitive only takes effect when debug_http is true.",
+		)
+	}
+	if !config.RateLimit.IsNull() {
+		transport = newRateLimitHttpTransport(transport, config.RateLimit.ValueInt32())
+	}
+	// Both piano id and publisher clients share a single http client so that they share the connection pool and the rate limit,
+	// and behave the same way with respect to logging and redaction.
+	httpClient := newRedactingHttpClient(&http.Client{
+		Transport: &deprecationHttpTransport{transport: transport},
+	}, apiToken, !(config.DebugHttp.ValueBool() && config.InsecureLogSensitive.ValueBool()))
+	idClient, err := piano_id.NewClient(idEndpoint, piano_id.WithHTTPClient(httpClient), func(client *piano_id.Client) error {
 		client.RequestEditors = append(client.RequestEditors, func(ctx context.Context, req *http.Request) error {
 			copied := req.URL.Query()
-			copied.Add("api_token", apiToken)
-			copied.Add("aid", appId)
+			if tokenSource == nil {
+				copied.Add("api_token", apiToken)
+			}
+			// Endpoints such as /publisher/customField take no aid parameter and rely on app_id of the provider,
+			// while /publisher/users/get takes aid from the data source. Adding app_id to the latter would send aid twice,
+			// so aid explicitly given in request parameters is preferred over the provider-level one.
+			if !copied.Has("aid") {
+				copied.Add("aid", appId)
+			}
 			req.URL.RawQuery = copied.Encode()
 			return nil
 		})
+		if tokenSource != nil {
+			client.RequestEditors = append(client.RequestEditors, bearerTokenRequestEditor(tokenSource))
+		}
 		return nil
-	})
+	}, piano_id.WithRequestEditorFn(userAgentRequestEditor(p.version)))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create Piano id client", fmt.Sprintf("Unable to create Piano id client due to %s", err))
 		return
 	}
-	client, err := piano_publisher.NewClient(endpoint, func(client *piano_publisher.Client) error {
+	client, err := piano_publisher.NewClient(endpoint, piano_publisher.WithHTTPClient(httpClient), func(client *piano_publisher.Client) error {
 		client.RequestEditors = append(client.RequestEditors, func(ctx context.Context, req *http.Request) error {
-			req.Header.Add("API_TOKEN", apiToken)
+			if tokenSource == nil {
+				req.Header.Add("API_TOKEN", apiToken)
+			}
 			return nil
 		})
+		if tokenSource != nil {
+			client.RequestEditors = append(client.RequestEditors, bearerTokenRequestEditor(tokenSource))
+		}
 		return nil
-	})
+	}, piano_publisher.WithRequestEditorFn(userAgentRequestEditor(p.version)))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create Piano publisher client", fmt.Sprintf("Unable to create Piano publisher client due to %s", err))
 		return
 	}
-	providerData := PianoProviderData{
+	providerData := &PianoProviderData{
 		publisherClient: *client,
 		idClient:        *idClient,
+		dateLocation:    dateLocation,
+		defaultAid:      defaultAid,
+		maxRetries:      maxRetries,
 	}
 
 	resp.ResourceData = providerData
@@ -158,8 +432,13 @@ func (p *PianoProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewOfferTermOrderResource,
 		NewCustomFieldResource,
 		NewContractDomainResource,
+		NewContractIpRangeResource,
+		NewContractUserResource,
 		NewPaymentTermV2Resource,
 		NewTermChangeOptionResource,
+		NewWebhookResource,
+		NewGrantAccessResource,
+		NewLinkedTermResource,
 	}
 }
 
@@ -172,10 +451,21 @@ func (p *PianoProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewLicenseeDataSource,
 		NewAppDataSource,
 		NewResourceDataSource,
+		NewResourcesDataSource,
 		NewContractDataSource,
 		NewTermDataSource,
 		NewExternalTermDataSource,
 		NewPromotionDataSource,
+		NewPromotionsDataSource,
+		NewPromotionCodeDataSource,
+		NewOfferTemplateDataSource,
+		NewOfferTemplatesDataSource,
+		NewUserDataSource,
+		NewAccessDataSource,
+		NewConversionDataSource,
+		NewTermsDataSource,
+		NewExternalAPIDataSource,
+		NewTermChangeOptionsDataSource,
 	}
 }
 
@@ -4,21 +4,49 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"terraform-provider-piano/internal/piano_id"
+	"terraform-provider-piano/internal/piano_publisher"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 )
 
 const (
 	// providerConfig is a shared configuration to combine with the actual
 	// test configuration so the piano client is properly configured.
+	// It does not reach piano.io API, so it is only suitable for tests which do not call the API.
 	providerConfig = `
 provider "piano" {
   endpoint = "https://sandbox.piano.io/api/v3"
   api_token = "**********************"
 }
 `
+	// testAccProviderConfig is the provider configuration of acceptance tests.
+	// The endpoint, API token and app_id are read from PIANO_ENDPOINT, PIANO_API_TOKEN and PIANO_APP_ID environment variables.
+	testAccProviderConfig = `
+provider "piano" {}
+`
+	// testAccSandboxEndpoint is the endpoint of acceptance tests when PIANO_ENDPOINT is not set.
+	testAccSandboxEndpoint = "https://sandbox.piano.io/api/v3"
+	// testAccNamePrefix is the prefix of the names of objects created by acceptance tests.
+	// Objects whose name starts with this prefix are safe to delete when a test run leaves them behind.
+	testAccNamePrefix = "tf-acc-test-"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -28,8 +56,336 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"piano": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccPreCheck validates that the environment variables acceptance tests depend on are set.
+// resource.Test runs it only when TF_ACC is set, so acceptance tests never call piano.io API otherwise.
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	for _, name := range []string{"PIANO_API_TOKEN", "PIANO_APP_ID"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+	if !aidPattern.MatchString(testAccAid()) {
+		t.Fatalf("PIANO_APP_ID %s", aidPatternDescription)
+	}
+	if os.Getenv("PIANO_ENDPOINT") == "" {
+		t.Setenv("PIANO_ENDPOINT", testAccSandboxEndpoint)
+	}
+}
+
+// testAccAid returns the application ID acceptance tests run against.
+func testAccAid() string {
+	return os.Getenv("PIANO_APP_ID")
+}
+
+// testAccName returns a random name with testAccNamePrefix for an object created by an acceptance test.
+func testAccName() string {
+	return acctest.RandomWithPrefix(testAccNamePrefix)
+}
+
+// testAccResourceConfig returns a configuration of piano_resource.test.
+func testAccResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "piano_resource" "test" {
+  name             = %q
+  is_fbia_resource = false
+}
+`, name)
+}
+
+// testAccPaymentTermV2Config returns a configuration of piano_payment_term_v2.test on piano_resource.test.
+func testAccPaymentTermV2Config(name string) string {
+	return testAccResourceConfig(name) + fmt.Sprintf(`
+resource "piano_payment_term_v2" "test" {
+  rid                  = piano_resource.test.rid
+  name                 = %q
+  payment_billing_plan = "[9.99 USD|1 month|*]"
+}
+`, name)
+}
+
+// testAccOfferConfig returns a configuration of piano_offer.test.
+func testAccOfferConfig(name string) string {
+	return fmt.Sprintf(`
+resource "piano_offer" "test" {
+  name = %q
+}
+`, name)
+}
+
+// testAccOfferTermBindingConfig returns a configuration of piano_offer_term_binding.test that binds piano_payment_term_v2.test to piano_offer.test.
+func testAccOfferTermBindingConfig(name string) string {
+	return testAccPaymentTermV2Config(name) + testAccOfferConfig(name) + `
+resource "piano_offer_term_binding" "test" {
+  offer_id = piano_offer.test.offer_id
+  term_id  = piano_payment_term_v2.test.term_id
+}
+`
+}
+
+func TestProviderDataConfiguresAllResourcesAndDataSources(t *testing.T) {
+	ctx := context.Background()
+	p := &PianoProvider{}
+	providerData := &PianoProviderData{}
+
+	for _, newResource := range p.Resources(ctx) {
+		r, ok := newResource().(resource.ResourceWithConfigure)
+		if !ok {
+			continue
+		}
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "piano"}, &metadata)
+		resp := resource.ConfigureResponse{}
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", metadata.TypeName, resp.Diagnostics)
+		}
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d, ok := newDataSource().(datasource.DataSourceWithConfigure)
+		if !ok {
+			continue
+		}
+		metadata := datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "piano"}, &metadata)
+		resp := datasource.ConfigureResponse{}
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", metadata.TypeName, resp.Diagnostics)
+		}
+	}
+}
+
+// TestResourcesConfigureDateLocation checks that the resources with `*_iso` attributes keep date_timezone of the provider.
+func TestResourcesConfigureDateLocation(t *testing.T) {
+	ctx := context.Background()
+	location := time.FixedZone("Asia/Tokyo", 9*60*60)
+	providerData := &PianoProviderData{dateLocation: location}
+	contract := &ContractResource{}
+	externalTerm := &ExternalTermResource{}
+	linkedTerm := &LinkedTermResource{}
+	paymentTerm := &PaymentTermResource{}
+	paymentTermV2 := &PaymentTermV2Resource{}
+	promotion := &PromotionResource{}
+	resourceResource := &ResourceResource{}
+	cases := []struct {
+		resource resource.ResourceWithConfigure
+		actual   func() *time.Location
+	}{
+		{resource: contract, actual: func() *time.Location { return contract.dateLocation }},
+		{resource: externalTerm, actual: func() *time.Location { return externalTerm.dateLocation }},
+		{resource: linkedTerm, actual: func() *time.Location { return linkedTerm.dateLocation }},
+		{resource: paymentTerm, actual: func() *time.Location { return paymentTerm.dateLocation }},
+		{resource: paymentTermV2, actual: func() *time.Location { return paymentTermV2.dateLocation }},
+		{resource: promotion, actual: func() *time.Location { return promotion.dateLocation }},
+		{resource: resourceResource, actual: func() *time.Location { return resourceResource.dateLocation }},
+	}
+	for _, c := range cases {
+		resp := resource.ConfigureResponse{}
+		c.resource.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%T: unexpected error: %v", c.resource, resp.Diagnostics)
+		}
+		if c.actual() != location {
+			t.Errorf("%T: expected date_timezone to be configured, got %v", c.resource, c.actual())
+		}
+	}
+}
+
+func TestResourcesDeleteAlreadyDeleted(t *testing.T) {
+	ctx := context.Background()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":404,"message":"Not found"}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &PianoProvider{}
+	providerData := &PianoProviderData{publisherClient: *client}
+	// resources that delete the object with a piano.io API request
+	typeNames := map[string]bool{
+		"piano_contract":           true,
+		"piano_contract_domain":    true,
+		"piano_contract_ip_range":  true,
+		"piano_external_term":      true,
+		"piano_grant_access":       true,
+		"piano_licensee":           true,
+		"piano_linked_term":        true,
+		"piano_offer":              true,
+		"piano_offer_term_binding": true,
+		"piano_payment_term":       true,
+		"piano_payment_term_v2":    true,
+		"piano_promotion":          true,
+		"piano_resource":           true,
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r, ok := newResource().(resource.ResourceWithConfigure)
+		if !ok {
+			continue
+		}
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "piano"}, &metadata)
+		if !typeNames[metadata.TypeName] {
+			continue
+		}
+		delete(typeNames, metadata.TypeName)
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			configureResp := resource.ConfigureResponse{}
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &configureResp)
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+				// identifiers are set so that the resource has an object to delete
+				if attributeType.Is(tftypes.String) {
+					values[name] = tftypes.NewValue(attributeType, "XXXXXXX")
+				}
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+			before := requests
+			resp := resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+			if requests == before {
+				t.Error("expected a delete request")
+			}
+		})
+	}
+	for typeName := range typeNames {
+		t.Errorf("resource %s is not provided", typeName)
+	}
+}
+
+func TestProviderClientsShareHttpClient(t *testing.T) {
+	ctx := context.Background()
+	p := &PianoProvider{version: "test"}
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	config := newTestConfig(t, schemaResp.Schema, &PianoProviderModel{
+		Endpoint:  types.StringValue("https://sandbox.piano.io/api/v3"),
+		ApiToken:  types.StringValue("token"),
+		AppId:     types.StringValue("example"),
+		DebugHttp: types.BoolValue(true),
+		RateLimit: types.Int32Value(10),
+	})
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	providerData := resp.ResourceData.(*PianoProviderData)
+
+	publisherHttpClient, ok := providerData.publisherClient.Client.(*redactingHttpClient)
+	if !ok {
+		t.Fatalf("expected publisher client to use redactingHttpClient, got %T", providerData.publisherClient.Client)
+	}
+	idHttpClient, ok := providerData.idClient.Client.(*redactingHttpClient)
+	if !ok {
+		t.Fatalf("expected id client to use redactingHttpClient, got %T", providerData.idClient.Client)
+	}
+	if publisherHttpClient != idHttpClient {
+		t.Errorf("expected both clients to share the same http client")
+	}
+	if publisherHttpClient.client.Transport != idHttpClient.client.Transport {
+		t.Errorf("expected both clients to share the same transport")
+	}
+}
+
+func TestProviderIdClientAid(t *testing.T) {
+	ctx := context.Background()
+	aids := map[string][]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		aids[req.URL.Path] = req.URL.Query()["aid"]
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	p := &PianoProvider{version: "test"}
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	config := newTestConfig(t, schemaResp.Schema, &PianoProviderModel{
+		Endpoint: types.StringValue(server.URL + "/api/v3"),
+		ApiToken: types.StringValue("token"),
+		AppId:    types.StringValue("example"),
+	})
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	providerData := resp.ResourceData.(*PianoProviderData)
+
+	response, err := providerData.idClient.PublisherCustomFieldPost(ctx, piano_id.PublisherCustomFieldPostJSONRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	response, err = providerData.idClient.PublisherUsersGet(ctx, &piano_id.PublisherUsersGetParams{Aid: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	expected := map[string][]string{
+		// app_id of the provider is sent to the endpoints without aid parameter
+		"/id/api/v1/publisher/customField": {"example"},
+		// aid given in the request is sent as is, not along with app_id
+		"/id/api/v1/publisher/users/get": {"other"},
+	}
+	if !reflect.DeepEqual(aids, expected) {
+		t.Errorf("expected aid %v, got %v", expected, aids)
+	}
+}
+
+func TestConfigureClients(t *testing.T) {
+	data, diags := configureClients(nil)
+	if data != nil || diags.HasError() {
+		t.Errorf("expected nil without error for unconfigured provider, got %v %v", data, diags)
+	}
+
+	expected := &PianoProviderData{}
+	data, diags = configureClients(expected)
+	if data != expected || diags.HasError() {
+		t.Errorf("expected provider data without error, got %v %v", data, diags)
+	}
+
+	for _, input := range []any{PianoProviderData{}, &piano_publisher.Client{}, "unexpected"} {
+		data, diags = configureClients(input)
+		if data != nil || !diags.HasError() {
+			t.Errorf("expected error for %T, got %v %v", input, data, diags)
+		}
+	}
+}
+
+func TestAidMatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		stateAid types.String
+		expected bool
+	}{
+		{name: "same aid", stateAid: types.StringValue("example"), expected: true},
+		{name: "null aid", stateAid: types.StringNull(), expected: true},
+		{name: "unknown aid", stateAid: types.StringUnknown(), expected: true},
+		{name: "different aid", stateAid: types.StringValue("wrong"), expected: false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			actual := AidMatches(c.stateAid, "example", &diags)
+			if actual != c.expected || diags.HasError() == c.expected {
+				t.Errorf("expected %t, got %t with %v", c.expected, actual, diags)
+			}
+		})
+	}
 }
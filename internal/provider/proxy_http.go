@@ -0,0 +1,35 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// parseProxyUrl parses proxy_url. Only http, https and socks5 proxies are supported as they are the ones http.Transport can use.
+func parseProxyUrl(input string) (*url.URL, error) {
+	proxyUrl, err := url.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	switch proxyUrl.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q, expected http, https or socks5", proxyUrl.Scheme)
+	}
+	if proxyUrl.Host == "" {
+		return nil, errors.New("proxy host is missing")
+	}
+	return proxyUrl, nil
+}
+
+// withProxyUrl returns a copy of transport that sends requests through proxyUrl regardless of HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func withProxyUrl(transport *http.Transport, proxyUrl *url.URL) *http.Transport {
+	ret := transport.Clone()
+	ret.Proxy = http.ProxyURL(proxyUrl)
+	return ret
+}
@@ -0,0 +1,32 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimitHttpTransport spaces out requests to piano.io API so that at most rate_limit requests are sent per second.
+// A request whose context is canceled, or would expire, before its turn is not sent and does not delay the others.
+type rateLimitHttpTransport struct {
+	transport http.RoundTripper
+	limiter   *rate.Limiter
+}
+
+func newRateLimitHttpTransport(transport http.RoundTripper, requestsPerSecond int32) *rateLimitHttpTransport {
+	return &rateLimitHttpTransport{
+		transport: transport,
+		// A burst of 1 keeps at least 1/requestsPerSecond between requests instead of allowing a burst after idling.
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), 1),
+	}
+}
+
+func (t *rateLimitHttpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitHttpTransport(t *testing.T) {
+	var mu sync.Mutex
+	received := []time.Time{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		received = append(received, time.Now())
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	interval := time.Second / 20
+	client := &http.Client{Transport: newRateLimitHttpTransport(http.DefaultTransport, 20)}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			response.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if len(received) != 5 {
+		t.Fatalf("expected 5 requests, got %d", len(received))
+	}
+	// allow a little jitter between the transport and the server
+	tolerance := 10 * time.Millisecond
+	for i := 1; i < len(received); i++ {
+		if spacing := received[i].Sub(received[i-1]); spacing < interval-tolerance {
+			t.Errorf("expected requests to be spaced by %s, got %s between request %d and %d", interval, spacing, i-1, i)
+		}
+	}
+}
+
+func TestRateLimitHttpTransportCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := newRateLimitHttpTransport(http.DefaultTransport, 1)
+	client := &http.Client{Transport: transport}
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rate.Limiter fails without waiting when the turn would come after the deadline.
+	if _, err := client.Do(req); err == nil {
+		t.Error("expected the request to be canceled before its turn")
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the waiting request to be canceled, got %v", err)
+	}
+}
+
+func TestRateLimitHttpTransportCanceledGivesTurnBack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	interval := time.Second / 2
+	client := &http.Client{Transport: newRateLimitHttpTransport(http.DefaultTransport, 2)}
+	start := time.Now()
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	// Several requests are canceled while waiting for the next slot.
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := client.Do(req); err == nil {
+				t.Error("expected the request to be canceled before its turn")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The next request takes the slot right after the first one instead of queueing behind the canceled ones.
+	response, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if elapsed := time.Since(start); elapsed > 2*interval {
+		t.Errorf("expected the canceled requests to give their slots back, the second request was sent after %s", elapsed)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactingHttpClient keeps the API token out of errors and logs produced while sending requests to piano.io API.
+// Errors returned by http.Client embed the request URL, which contains the API token for piano ID API,
+// and resources surface them as diagnostics as is.
+type redactingHttpClient struct {
+	client *http.Client
+	// secrets are replaced with redacted in errors and log messages
+	secrets []string
+	// maskLogs masks secrets in log messages and fields. It is false when insecure_log_sensitive is enabled.
+	maskLogs bool
+}
+
+// newRedactingHttpClient returns a redactingHttpClient which hides apiToken in both raw and URL-encoded forms.
+func newRedactingHttpClient(client *http.Client, apiToken string, maskLogs bool) *redactingHttpClient {
+	secrets := []string{}
+	if apiToken != "" {
+		secrets = append(secrets, apiToken)
+		if escaped := url.QueryEscape(apiToken); escaped != apiToken {
+			secrets = append(secrets, escaped)
+		}
+	}
+	return &redactingHttpClient{client: client, secrets: secrets, maskLogs: maskLogs}
+}
+
+func (c *redactingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if len(c.secrets) == 0 {
+		return c.client.Do(req)
+	}
+	if c.maskLogs {
+		ctx := tflog.MaskMessageStrings(req.Context(), c.secrets...)
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.secrets...)
+		req = req.WithContext(ctx)
+	}
+	response, err := c.client.Do(req)
+	if err != nil {
+		return response, &redactedError{err: err, secrets: c.secrets}
+	}
+	return response, nil
+}
+
+// redactedError hides secrets from the message of the wrapped error.
+type redactedError struct {
+	err     error
+	secrets []string
+}
+
+func (e *redactedError) Error() string {
+	message := e.err.Error()
+	for _, secret := range e.secrets {
+		message = strings.ReplaceAll(message, secret, redacted)
+	}
+	return message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-piano/internal/piano_id"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRedactingHttpClientMasksTokenInDiagnostics(t *testing.T) {
+	const token = "secret+api/token"
+	ctx := context.Background()
+	// Requests to a closed server fail with an error embedding the request URL.
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	client, err := piano_id.NewClient(server.URL, piano_id.WithHTTPClient(newRedactingHttpClient(&http.Client{}, token, true)), func(client *piano_id.Client) error {
+		client.RequestEditors = append(client.RequestEditors, func(ctx context.Context, req *http.Request) error {
+			query := req.URL.Query()
+			query.Add("api_token", token)
+			req.URL.RawQuery = query.Encode()
+			return nil
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &CustomFieldResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &CustomFieldResourceModel{
+		Aid:               types.StringValue("example"),
+		FieldName:         types.StringValue("nickname"),
+		Title:             types.StringValue("Nickname"),
+		Editable:          types.BoolValue(true),
+		DataType:          types.StringValue("TEXT"),
+		RequiredByDefault: types.BoolValue(false),
+		Archived:          types.BoolUnknown(),
+	})
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if !createResp.Diagnostics.HasError() {
+		t.Fatal("expected an error from the closed server")
+	}
+	for _, d := range createResp.Diagnostics {
+		if strings.Contains(d.Detail(), "secret") {
+			t.Errorf("expected token to be redacted, got %s", d.Detail())
+		}
+		if !strings.Contains(d.Detail(), "api_token="+redacted) {
+			t.Errorf("expected redacted token in the error, got %s", d.Detail())
+		}
+	}
+}
+
+func TestRedactingHttpClientMasksTokenInLogs(t *testing.T) {
+	const token = "secret-api-token"
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		tflog.Debug(req.Context(), "sending "+token, map[string]any{"token": token})
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	})
+
+	for _, maskLogs := range []bool{true, false} {
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://sandbox.piano.io/api/v3/publisher/app/get", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := newRedactingHttpClient(&http.Client{Transport: transport}, token, maskLogs)
+		if _, err := client.Do(req); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(output.String(), token) == maskLogs {
+			t.Errorf("maskLogs %t: unexpected log output %s", maskLogs, output.String())
+		}
+	}
+}
@@ -28,7 +28,7 @@ func NewResourceDataSource() datasource.DataSource {
 
 // ResourceDataSource defines the data source implementation.
 type ResourceDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 // ResourceDataSourceModel describes the data source data model.
@@ -69,6 +69,7 @@ func (d *ResourceDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			"aid": schema.StringAttribute{
 				MarkdownDescription: "The application ID",
 				Required:            true,
+				Validators:          aidValidators(),
 			},
 			"deleted": schema.BoolAttribute{
 				MarkdownDescription: "Whether the object is deleted",
@@ -139,18 +140,9 @@ func (d *ResourceDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 }
 
 func (d *ResourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
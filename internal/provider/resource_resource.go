@@ -11,7 +11,10 @@ import (
 	"strings"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,8 +31,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ResourceResource{}
-	_ resource.ResourceWithImportState = &ResourceResource{}
+	_ resource.Resource                 = &ResourceResource{}
+	_ resource.ResourceWithImportState  = &ResourceResource{}
+	_ resource.ResourceWithUpgradeState = &ResourceResource{}
+	_ resource.ResourceWithModifyPlan   = &ResourceResource{}
 )
 
 func NewResourceResource() resource.Resource {
@@ -37,27 +43,50 @@ func NewResourceResource() resource.Resource {
 
 // ResourceResource defines the resource implementation.
 type ResourceResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
 }
 
 // ResourceResourceModel describes the resource model.
 type ResourceResourceModel struct {
-	Rid            types.String `tfsdk:"rid"`              // The resource ID
-	Aid            types.String `tfsdk:"aid"`              // The application ID
-	Deleted        types.Bool   `tfsdk:"deleted"`          // Whether the object is deleted
-	Disabled       types.Bool   `tfsdk:"disabled"`         // Whether the object is disabled
-	CreateDate     types.Int64  `tfsdk:"create_date"`      // The creation date
-	UpdateDate     types.Int64  `tfsdk:"update_date"`      // The update date
-	PublishDate    types.Int64  `tfsdk:"publish_date"`     // The publish date
-	Name           types.String `tfsdk:"name"`             // The name
-	Description    types.String `tfsdk:"description"`      // The resource description
-	ImageUrl       types.String `tfsdk:"image_url"`        // The URL of the resource image
-	Type           types.String `tfsdk:"type"`             // The type of the resource (0: Standard, 4: Bundle)
-	BundleType     types.String `tfsdk:"bundle_type"`      // The resource bundle type
-	PurchaseUrl    types.String `tfsdk:"purchase_url"`     // The URL of the purchase page
-	ResourceUrl    types.String `tfsdk:"resource_url"`     // The URL of the resource
-	ExternalId     types.String `tfsdk:"external_id"`      // The external ID; defined by the client
-	IsFbiaResource types.Bool   `tfsdk:"is_fbia_resource"` // Enable the resource for Facebook Subscriptions in Instant Articles
+	Rid             types.String `tfsdk:"rid"`               // The resource ID
+	Aid             types.String `tfsdk:"aid"`               // The application ID
+	Deleted         types.Bool   `tfsdk:"deleted"`           // Whether the object is deleted
+	Disabled        types.Bool   `tfsdk:"disabled"`          // Whether the object is disabled
+	CreateDate      types.Int64  `tfsdk:"create_date"`       // The creation date
+	UpdateDate      types.Int64  `tfsdk:"update_date"`       // The update date
+	PublishDate     types.Int64  `tfsdk:"publish_date"`      // The publish date
+	Published       types.Bool   `tfsdk:"published"`         // Whether the resource is published
+	Name            types.String `tfsdk:"name"`              // The name
+	Description     types.String `tfsdk:"description"`       // The resource description
+	ImageUrl        types.String `tfsdk:"image_url"`         // The URL of the resource image
+	Type            types.String `tfsdk:"type"`              // The type of the resource (0: Standard, 4: Bundle)
+	TypeLabel       types.String `tfsdk:"type_label"`        // The resource type label ("Standard" or "Bundle")
+	BundleType      types.String `tfsdk:"bundle_type"`       // The resource bundle type
+	BundleTypeLabel types.String `tfsdk:"bundle_type_label"` // The bundle type label
+	PurchaseUrl     types.String `tfsdk:"purchase_url"`      // The URL of the purchase page
+	ResourceUrl     types.String `tfsdk:"resource_url"`      // The URL of the resource
+	ExternalId      types.String `tfsdk:"external_id"`       // The external ID; defined by the client
+	IsFbiaResource  types.Bool   `tfsdk:"is_fbia_resource"`  // Enable the resource for Facebook Subscriptions in Instant Articles
+}
+
+// ResourceResourceWithTimeoutsModel describes the model of piano_resource.
+// ResourceResourceModel is kept without timeouts and the `*_iso` attributes as it is also nested in term resources.
+type ResourceResourceWithTimeoutsModel struct {
+	ResourceResourceModel
+	CreateDateIso  types.String `tfsdk:"create_date_iso"`  // The creation date in RFC3339 format
+	UpdateDateIso  types.String `tfsdk:"update_date_iso"`  // The update date in RFC3339 format
+	PublishDateIso types.String `tfsdk:"publish_date_iso"` // The publish date in RFC3339 format
+	Timeouts       types.Object `tfsdk:"timeouts"`
+}
+
+// setUnixTimeIso fills the `*_iso` attributes from the unix times in the given location.
+func (m *ResourceResourceWithTimeoutsModel) setUnixTimeIso(location *time.Location) {
+	m.CreateDateIso = unixTimeIsoFrom(m.CreateDate, location)
+	m.UpdateDateIso = unixTimeIsoFrom(m.UpdateDate, location)
+	m.PublishDateIso = unixTimeIsoFrom(m.PublishDate, location)
 }
 
 func (r *ResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,13 +95,11 @@ func (r *ResourceResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: "Resource resource. Resources are fundamental concept used to control access to " +
 			"content you’re gating (e.g. an article, a movie, a blog post, a pdf, access to a forum, access to premium site content, etc.) in piano.io.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				MarkdownDescription: "The application ID",
-				Required:            true,
-			},
+			"aid": defaultAidAttribute(),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name",
 				Required:            true,
@@ -85,7 +112,7 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "The resource description",
+				MarkdownDescription: "The resource description. An empty string is kept as an empty string to clear the description, while an unset description is kept as null",
 				Optional:            true,
 			},
 			"deleted": schema.BoolAttribute{
@@ -97,10 +124,12 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 				Default:  booldefault.StaticBool(false),
 			},
 			"disabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether the object is disabled",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
+				// piano.io publisher API does not provide endpoints to disable or enable terms individually.
+				MarkdownDescription: "Whether the object is disabled. Use this attribute to retire a resource together with its terms " +
+					"as terms cannot be disabled or enabled one by one via piano.io publisher API.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"create_date": schema.Int64Attribute{
 				MarkdownDescription: "The creation date timestamp",
@@ -120,9 +149,18 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"create_date_iso":  unixTimeIsoAttribute("The creation date"),
+			"update_date_iso":  unixTimeIsoAttribute("The update date"),
+			"publish_date_iso": unixTimeIsoAttribute("The publish date"),
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether the resource is published. When this value is set, the resource is published or unpublished " +
+					"by updating `publish_date` so that it matches this value. `publish_date` is left as is when this value is null.",
+				Optional: true,
+			},
 			"image_url": schema.StringAttribute{
-				MarkdownDescription: "The URL of the resource image",
-				Optional:            true,
+				MarkdownDescription: "The URL of the resource image. piano.io API does not upload images, so this value must be a URL of an image hosted elsewhere. " +
+					"To use an image uploaded in piano.io dashboard, copy the URL of the uploaded image here.",
+				Optional: true,
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of the resource (0: Standard, 4: Bundle)",
@@ -131,6 +169,16 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"type_label": schema.StringAttribute{
+				MarkdownDescription: "The resource type label ('Standard', 'Bundle' or 'Print')",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Standard", "Bundle", "Print"),
+				},
+			},
 			"bundle_type": schema.StringAttribute{
 				MarkdownDescription: "The resource bundle type",
 				Computed:            true,
@@ -138,12 +186,22 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"bundle_type_label": schema.StringAttribute{
+				MarkdownDescription: "The bundle type label ('Fixed', 'Fixed 2.0', 'Tagged' or 'Undefined'). This value is null for non-bundle resources.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Fixed", "Fixed 2.0", "Tagged", "Undefined"),
+				},
+			},
 			"purchase_url": schema.StringAttribute{
 				MarkdownDescription: "The URL of the purchase page",
 				Optional:            true,
 			},
 			"resource_url": schema.StringAttribute{
-				MarkdownDescription: "The URL of the resource",
+				MarkdownDescription: "The URL of the resource. This is not applicable to bundle resources.",
 				Optional:            true,
 			},
 			"external_id": schema.StringAttribute{
@@ -151,39 +209,102 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:            true,
 			},
 			"is_fbia_resource": schema.BoolAttribute{
-				MarkdownDescription: "Enable the resource for Facebook Subscriptions in Instant Articles",
+				MarkdownDescription: "Enable the resource for Facebook Subscriptions in Instant Articles. Enabling this on a bundle resource is reported as a warning.",
 				Required:            true,
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}
 }
 
-func (r *ResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
+// resourceResourceModelV0 describes the state of the resource at schema version 0.
+type resourceResourceModelV0 struct {
+	Rid            *string `json:"rid"`
+	Aid            *string `json:"aid"`
+	Deleted        *bool   `json:"deleted"`
+	Disabled       *bool   `json:"disabled"`
+	CreateDate     *int64  `json:"create_date"`
+	UpdateDate     *int64  `json:"update_date"`
+	PublishDate    *int64  `json:"publish_date"`
+	Name           *string `json:"name"`
+	Description    *string `json:"description"`
+	ImageUrl       *string `json:"image_url"`
+	Type           *string `json:"type"`
+	BundleType     *string `json:"bundle_type"`
+	PurchaseUrl    *string `json:"purchase_url"`
+	ResourceUrl    *string `json:"resource_url"`
+	ExternalId     *string `json:"external_id"`
+	IsFbiaResource *bool   `json:"is_fbia_resource"`
+}
 
-	client, ok := req.ProviderData.(PianoProviderData)
+func (r *ResourceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior resourceResourceModelV0
+				if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to decode version 0 state, got error: %s", err))
+					return
+				}
+				upgraded := ResourceResourceWithTimeoutsModel{
+					ResourceResourceModel: resourceResourceModelFromV0(prior),
+					Timeouts:              timeoutsNull(),
+				}
+				upgraded.setUnixTimeIso(r.dateLocation)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+// resourceResourceModelFromV0 converts version 0 state into the current model.
+// Attributes absent in version 0 state are filled with the defaults the schema would plan.
+func resourceResourceModelFromV0(data resourceResourceModelV0) ResourceResourceModel {
+	ret := ResourceResourceModel{}
+	ret.Rid = types.StringPointerValue(data.Rid)
+	ret.Aid = types.StringPointerValue(data.Aid)
+	ret.Deleted = types.BoolValue(data.Deleted != nil && *data.Deleted)
+	ret.Disabled = types.BoolValue(data.Disabled != nil && *data.Disabled)
+	ret.CreateDate = types.Int64PointerValue(data.CreateDate)
+	ret.UpdateDate = types.Int64PointerValue(data.UpdateDate)
+	ret.PublishDate = types.Int64PointerValue(data.PublishDate)
+	ret.Name = types.StringPointerValue(data.Name)
+	ret.Description = types.StringPointerValue(data.Description)
+	ret.ImageUrl = types.StringPointerValue(data.ImageUrl)
+	ret.Type = types.StringPointerValue(data.Type)
+	ret.TypeLabel = types.StringNull()
+	ret.BundleType = types.StringPointerValue(data.BundleType)
+	ret.BundleTypeLabel = types.StringNull()
+	ret.PurchaseUrl = types.StringPointerValue(data.PurchaseUrl)
+	ret.ResourceUrl = types.StringPointerValue(data.ResourceUrl)
+	ret.ExternalId = types.StringPointerValue(data.ExternalId)
+	ret.IsFbiaResource = types.BoolValue(data.IsFbiaResource != nil && *data.IsFbiaResource)
+	return ret
+}
 
+func (r *ResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
 }
 
 func (r *ResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var state ResourceResourceModel
+	var state ResourceResourceWithTimeoutsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// the create request takes only name and description, so the other planned values are sent in the update request after creation
+	plan := state
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutCreate)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("creating resource %s in %s", state.Name.ValueString(), state.Aid.ValueString()))
 	response, err := r.client.PostPublisherResourceCreateWithFormdataBody(ctx, piano_publisher.PostPublisherResourceCreateFormdataRequestBody{
@@ -206,6 +327,9 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "rid", result.Resource.Rid, &resp.Diagnostics) {
+		return
+	}
 	// Computed, ReadOnly
 	state.Rid = types.StringValue(result.Resource.Rid)
 	state.CreateDate = types.Int64Value(int64(result.Resource.CreateDate))
@@ -213,14 +337,12 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 	state.PublishDate = types.Int64Value(int64(result.Resource.PublishDate))
 	state.Deleted = types.BoolValue(result.Resource.Deleted)
 	state.Type = types.StringValue(string(result.Resource.Type))
+	state.TypeLabel = types.StringValue(string(result.Resource.TypeLabel))
 	state.BundleType = types.StringPointerValue((*string)(result.Resource.BundleType))
+	state.BundleTypeLabel = types.StringPointerValue((*string)(result.Resource.BundleTypeLabel))
 	// Updatable
 	state.Name = types.StringValue(result.Resource.Name)
-	if state.Description.IsNull() && result.Resource.Description != nil && *result.Resource.Description == "" {
-		result.Resource.Description = nil
-	} else {
-		state.Description = types.StringPointerValue(result.Resource.Description)
-	}
+	state.Description = syntax.ReconcileOptionalStringWithMode(state.Description, result.Resource.Description, syntax.EmptyStringAsValue)
 	state.ExternalId = types.StringPointerValue(result.Resource.ExternalId)
 	state.ImageUrl = types.StringPointerValue(result.Resource.ImageUrl)
 	state.ResourceUrl = types.StringPointerValue(result.Resource.ResourceUrl)
@@ -229,17 +351,18 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 	// Not-Updatable
 	state.PurchaseUrl = types.StringPointerValue(result.Resource.PurchaseUrl)
 
-	tflog.Info(ctx, fmt.Sprintf("updating Resource(id:%s) %s in %s as is_fbia_resource is not modify-able in create request", state.Rid.ValueString(), state.Name.ValueString(), state.Aid.ValueString()))
+	tflog.Info(ctx, fmt.Sprintf("updating Resource(id:%s) %s in %s as is_fbia_resource and disabled are not modify-able in create request", state.Rid.ValueString(), state.Name.ValueString(), state.Aid.ValueString()))
 	request := piano_publisher.PostPublisherResourceUpdateFormdataRequestBody{
 		Aid:            state.Aid.ValueString(),
 		Rid:            state.Rid.ValueString(),
 		Name:           state.Name.ValueStringPointer(),
 		Description:    state.Description.ValueStringPointer(),
-		Disabled:       state.Disabled.ValueBoolPointer(),
-		ExternalId:     state.ExternalId.ValueStringPointer(),
-		ImageUrl:       state.ImageUrl.ValueStringPointer(),
-		IsFbiaResource: state.IsFbiaResource.ValueBoolPointer(),
-		ResourceUrl:    state.ResourceUrl.ValueStringPointer(),
+		Disabled:       plan.Disabled.ValueBoolPointer(),
+		ExternalId:     plan.ExternalId.ValueStringPointer(),
+		ImageUrl:       plan.ImageUrl.ValueStringPointer(),
+		IsFbiaResource: plan.IsFbiaResource.ValueBoolPointer(),
+		ResourceUrl:    plan.ResourceUrl.ValueStringPointer(),
+		PublishDate:    resourcePublishDateFor(state.Published, state.PublishDate.ValueInt64(), time.Now()),
 	}
 
 	response, err = r.client.PostPublisherResourceUpdateWithFormdataBody(ctx, request)
@@ -260,20 +383,30 @@ func (r *ResourceResource) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	state.UpdateDate = types.Int64Value(int64(result.Resource.UpdateDate))
+	state.ExternalId = types.StringPointerValue(result.Resource.ExternalId)
+	state.ImageUrl = types.StringPointerValue(result.Resource.ImageUrl)
+	state.ResourceUrl = types.StringPointerValue(result.Resource.ResourceUrl)
 	state.IsFbiaResource = types.BoolValue(result.Resource.IsFbiaResource)
+	state.Disabled = types.BoolValue(result.Resource.Disabled)
+	state.PublishDate = types.Int64Value(int64(result.Resource.PublishDate))
+	checkResourceDisabled(plan.Disabled, result.Resource.Disabled, &resp.Diagnostics)
 
+	state.setUnixTimeIso(r.dateLocation)
 	tflog.Info(ctx, fmt.Sprintf("complete creating resource %s(id: %s)", state.Name, state.Rid))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state ResourceResourceModel
+	var state ResourceResourceWithTimeoutsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutRead)
+	defer cancel()
 	response, err := r.client.GetPublisherResourceGet(ctx, &piano_publisher.GetPublisherResourceGetParams{
 		Aid: state.Aid.ValueString(),
 		Rid: state.Rid.ValueString(),
@@ -293,6 +426,9 @@ func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "rid", result.Resource.Rid, &resp.Diagnostics) {
+		return
+	}
 
 	// Computed, ReadOnly
 	state.CreateDate = types.Int64Value(int64(result.Resource.CreateDate))
@@ -300,13 +436,12 @@ func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.PublishDate = types.Int64Value(int64(result.Resource.PublishDate))
 	state.Deleted = types.BoolValue(result.Resource.Deleted)
 	state.Type = types.StringValue(string(result.Resource.Type))
+	state.TypeLabel = types.StringValue(string(result.Resource.TypeLabel))
 	state.BundleType = types.StringPointerValue((*string)(result.Resource.BundleType))
+	state.BundleTypeLabel = types.StringPointerValue((*string)(result.Resource.BundleTypeLabel))
 	// Updatable
 	state.Name = types.StringValue(result.Resource.Name)
-	if state.Description.IsNull() && result.Resource.Description != nil && *result.Resource.Description == "" {
-		result.Resource.Description = nil
-	}
-	state.Description = types.StringPointerValue(result.Resource.Description)
+	state.Description = syntax.ReconcileOptionalStringWithMode(state.Description, result.Resource.Description, syntax.EmptyStringAsValue)
 	state.ExternalId = types.StringPointerValue(result.Resource.ExternalId)
 	state.ImageUrl = types.StringPointerValue(result.Resource.ImageUrl)
 	state.ResourceUrl = types.StringPointerValue(result.Resource.ResourceUrl)
@@ -314,17 +449,77 @@ func (r *ResourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.Disabled = types.BoolValue(result.Resource.Disabled)
 	// Not-Updatable
 	state.PurchaseUrl = types.StringPointerValue(result.Resource.PurchaseUrl)
+	if !state.Published.IsNull() {
+		state.Published = types.BoolValue(isResourcePublished(state.PublishDate.ValueInt64(), time.Now()))
+	}
+	state.setUnixTimeIso(r.dateLocation)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// ModifyPlan marks publish_date as unknown when the planned published value requires publishing or unpublishing the resource.
+// It also validates the plan against the resource type, which is known only after the resource is created or imported.
+// The `*_iso` attributes are planned from the planned dates.
+func (r *ResourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state ResourceResourceWithTimeoutsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateResourceTypeCompatibility(state.Type.ValueString(), plan.ResourceResourceModel)...)
+	if resourcePublishDateFor(plan.Published, state.PublishDate.ValueInt64(), time.Now()) != nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("publish_date"), types.Int64Unknown())...)
+	}
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "create_date", "update_date", "publish_date")
+}
+
+// validateResourceTypeCompatibility rejects attributes that are not applicable to the given resource type.
+//
+//   - resource_url is not allowed for bundle resources as a bundle has no content page of its own.
+//   - is_fbia_resource on bundle resources is reported as a warning as Facebook Instant Articles subscriptions are meant for standard resources.
+//
+// Resource type is computed, so the validation is skipped when the type is not known yet.
+func validateResourceTypeCompatibility(resourceType string, plan ResourceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if resourceType != string(piano_publisher.ResourceTypeBundle) {
+		return diags
+	}
+	if !plan.ResourceUrl.IsNull() && !plan.ResourceUrl.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("resource_url"),
+			"Invalid Attribute Combination",
+			"resource_url is not applicable to bundle resources. Remove resource_url or use a standard resource.",
+		)
+	}
+	if plan.IsFbiaResource.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("is_fbia_resource"),
+			"Attribute Not Applicable to Bundle Resource",
+			"is_fbia_resource is enabled on a bundle resource. Facebook Subscriptions in Instant Articles are meant for standard resources.",
+		)
+	}
+	return diags
+}
+
 func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// NOTE: This state contains only updated values at first
-	var state ResourceResourceModel
+	var state ResourceResourceWithTimeoutsModel
+	var prior ResourceResourceWithTimeoutsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutUpdate)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("updating resource %s(id:%s) in %s", state.Name.ValueString(), state.Rid.ValueString(), state.Aid.ValueString()))
 	request := piano_publisher.PostPublisherResourceUpdateFormdataRequestBody{
@@ -337,6 +532,7 @@ func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateReques
 		ImageUrl:       state.ImageUrl.ValueStringPointer(),
 		IsFbiaResource: state.IsFbiaResource.ValueBoolPointer(),
 		ResourceUrl:    state.ResourceUrl.ValueStringPointer(),
+		PublishDate:    resourcePublishDateFor(state.Published, prior.PublishDate.ValueInt64(), time.Now()),
 	}
 
 	response, err := r.client.PostPublisherResourceUpdateWithFormdataBody(ctx, request)
@@ -363,32 +559,35 @@ func (r *ResourceResource) Update(ctx context.Context, req resource.UpdateReques
 	state.PublishDate = types.Int64Value(int64(result.Resource.PublishDate))
 	state.Deleted = types.BoolValue(result.Resource.Deleted)
 	state.Type = types.StringValue(string(result.Resource.Type))
+	state.TypeLabel = types.StringValue(string(result.Resource.TypeLabel))
 	state.BundleType = types.StringPointerValue((*string)(result.Resource.BundleType))
+	state.BundleTypeLabel = types.StringPointerValue((*string)(result.Resource.BundleTypeLabel))
 	// Updatable
 	state.Name = types.StringValue(result.Resource.Name)
-	if state.Description.IsNull() && result.Resource.Description != nil && *result.Resource.Description == "" {
-		result.Resource.Description = nil
-	}
-	state.Description = types.StringPointerValue(result.Resource.Description)
+	state.Description = syntax.ReconcileOptionalStringWithMode(state.Description, result.Resource.Description, syntax.EmptyStringAsValue)
 	state.ExternalId = types.StringPointerValue(result.Resource.ExternalId)
 	state.ImageUrl = types.StringPointerValue(result.Resource.ImageUrl)
 	state.ResourceUrl = types.StringPointerValue(result.Resource.ResourceUrl)
 	state.IsFbiaResource = types.BoolValue(result.Resource.IsFbiaResource)
+	checkResourceDisabled(state.Disabled, result.Resource.Disabled, &resp.Diagnostics)
 	state.Disabled = types.BoolValue(result.Resource.Disabled)
 	// Not-Updatable
 	state.PurchaseUrl = types.StringPointerValue(result.Resource.PurchaseUrl)
 
+	state.setUnixTimeIso(r.dateLocation)
 	tflog.Info(ctx, fmt.Sprintf("complete updating resource %s(id: %s)", state.Name, state.Rid))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state ResourceResourceModel
+	var state ResourceResourceWithTimeoutsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutDelete)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("deleting Resource %s:%s in $%s", state.Name.ValueString(), state.Rid.ValueString(), state.Aid.ValueString()))
 	response, err := r.client.PostPublisherResourceDeleteWithFormdataBody(ctx, piano_publisher.PostPublisherResourceDeleteFormdataRequestBody{
@@ -399,7 +598,7 @@ func (r *ResourceResource) Delete(ctx context.Context, req resource.DeleteReques
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -415,15 +614,6 @@ func (r *ResourceResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rid"), resourceId.ResourceId)...)
 }
 
-func ResourceManagerUidsStringFromModels(models []ManagerResourceModel) string {
-	managerUids := []string{}
-	for _, m := range models {
-		managerUids = append(managerUids, m.UID.ValueString())
-	}
-	managerUidsAsString := strings.Join(managerUids, ",")
-	return managerUidsAsString
-}
-
 // ResourceResourceId represents a piano.io Resource resource identifier in "{aid}/{rid}" format.
 type ResourceResourceId struct {
 	Aid        string
@@ -441,3 +631,35 @@ func ResourceResourceIdFromString(input string) (*ResourceResourceId, error) {
 	}
 	return &data, nil
 }
+
+// isResourcePublished reports whether a resource with the given publish date is published at now.
+func isResourcePublished(publishDate int64, now time.Time) bool {
+	return publishDate > 0 && publishDate <= now.Unix()
+}
+
+// resourcePublishDateFor returns the publish date to send to piano.io so that the resource becomes published or unpublished as desired.
+// It returns nil when the desired state is not set or the resource is already in the desired state.
+func resourcePublishDateFor(published types.Bool, publishDate int64, now time.Time) *int {
+	if published.IsNull() || published.IsUnknown() || published.ValueBool() == isResourcePublished(publishDate, now) {
+		return nil
+	}
+	publishDate = 0
+	if published.ValueBool() {
+		publishDate = now.Unix()
+	}
+	ret := int(publishDate)
+	return &ret
+}
+
+// checkResourceDisabled reports an error when piano.io did not disable or enable the resource as planned.
+// Without the check, the next plan would silently try to apply the same change again.
+func checkResourceDisabled(planned types.Bool, disabled bool, diagnostics *diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() || planned.ValueBool() == disabled {
+		return
+	}
+	diagnostics.AddAttributeError(
+		path.Root("disabled"),
+		"Unexpected Disabled State",
+		fmt.Sprintf("piano.io API returned disabled=%t for the resource planned with disabled=%t.", disabled, planned.ValueBool()),
+	)
+}
@@ -0,0 +1,372 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestResourceResourceUpgradeStateFromV0(t *testing.T) {
+	ctx := context.Background()
+	r := &ResourceResource{}
+	current := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("expected a state upgrader from version 0")
+	}
+
+	// version 0 state without deleted and disabled attributes
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"aid": "example",
+			"rid": "RXXXXXXX",
+			"name": "Premium",
+			"description": null,
+			"create_date": 1735657200,
+			"update_date": 1735657200,
+			"publish_date": 1735657200,
+			"type": "standard",
+			"bundle_type": "undefined",
+			"is_fbia_resource": false
+		}`)},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: newTestState(t, current.Schema, nil),
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var actual ResourceResourceWithTimeoutsModel
+	if diags := resp.State.Get(ctx, &actual); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if actual.Aid.ValueString() != "example" || actual.Rid.ValueString() != "RXXXXXXX" || actual.Name.ValueString() != "Premium" {
+		t.Errorf("expected identifiers to be kept, got %s %s %s", actual.Aid, actual.Rid, actual.Name)
+	}
+	if actual.Deleted.IsNull() || actual.Deleted.ValueBool() {
+		t.Errorf("expected deleted to default to false, got %s", actual.Deleted)
+	}
+	if actual.Disabled.IsNull() || actual.Disabled.ValueBool() {
+		t.Errorf("expected disabled to default to false, got %s", actual.Disabled)
+	}
+	if !actual.Description.IsNull() || !actual.ImageUrl.IsNull() {
+		t.Errorf("expected unset optional attributes to stay null, got %s %s", actual.Description, actual.ImageUrl)
+	}
+	if actual.CreateDate.ValueInt64() != 1735657200 {
+		t.Errorf("expected create_date to be kept, got %s", actual.CreateDate)
+	}
+}
+
+func TestResourceResourceUpdatePublished(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour).Unix()
+	cases := []struct {
+		name                string
+		published           types.Bool
+		priorPublishDate    int64
+		expectedPublishDate bool
+		expectedPublished   bool
+	}{
+		{name: "publish unpublished resource", published: types.BoolValue(true), priorPublishDate: 0, expectedPublishDate: true, expectedPublished: true},
+		{name: "unpublish published resource", published: types.BoolValue(false), priorPublishDate: past, expectedPublishDate: true, expectedPublished: false},
+		{name: "keep published resource", published: types.BoolValue(true), priorPublishDate: past, expectedPublished: true},
+		{name: "published is not set", published: types.BoolNull(), priorPublishDate: past, expectedPublished: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/resource/update" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				if err := req.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				publishDate := c.priorPublishDate
+				if value, ok := req.PostForm["publish_date"]; ok != c.expectedPublishDate {
+					t.Errorf("expected publish_date to be sent: %t, got %v", c.expectedPublishDate, req.PostForm)
+				} else if ok {
+					parsed, err := strconv.ParseInt(value[0], 10, 64)
+					if err != nil {
+						t.Fatal(err)
+					}
+					publishDate = parsed
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"code":0,"resource":{"aid":"example","rid":"RXXXXXXX","name":"Premium","type":"standard","type_label":"Standard","publish_date":%d,"create_date":1735657200,"update_date":1735657200}}`, publishDate)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &ResourceResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			prior := ResourceResourceWithTimeoutsModel{ResourceResourceModel: ResourceResourceModel{
+				Aid:            types.StringValue("example"),
+				Rid:            types.StringValue("RXXXXXXX"),
+				Name:           types.StringValue("Premium"),
+				Deleted:        types.BoolValue(false),
+				Disabled:       types.BoolValue(false),
+				CreateDate:     types.Int64Value(1735657200),
+				UpdateDate:     types.Int64Value(1735657200),
+				PublishDate:    types.Int64Value(c.priorPublishDate),
+				Published:      types.BoolNull(),
+				Type:           types.StringValue("standard"),
+				IsFbiaResource: types.BoolValue(false),
+			}, Timeouts: timeoutsNull()}
+			state := newTestState(t, schemaResp.Schema, &prior)
+			planned := prior
+			planned.Published = c.published
+			planned.UpdateDate = types.Int64Unknown()
+			plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual ResourceResourceWithTimeoutsModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !actual.Published.Equal(c.published) {
+				t.Errorf("expected published to be %s, got %s", c.published, actual.Published)
+			}
+			if isResourcePublished(actual.PublishDate.ValueInt64(), time.Now()) != c.expectedPublished {
+				t.Errorf("expected published: %t, got publish_date %s", c.expectedPublished, actual.PublishDate)
+			}
+			if actual.TypeLabel.ValueString() != "Standard" || !actual.BundleTypeLabel.IsNull() {
+				t.Errorf("expected labels from the response, got %s %s", actual.TypeLabel, actual.BundleTypeLabel)
+			}
+		})
+	}
+}
+
+func TestValidateResourceTypeCompatibility(t *testing.T) {
+	cases := []struct {
+		name             string
+		resourceType     string
+		resourceUrl      types.String
+		isFbiaResource   bool
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{name: "standard resource with resource_url and is_fbia_resource", resourceType: "standard", resourceUrl: types.StringValue("https://example.com"), isFbiaResource: true},
+		{name: "unknown type", resourceType: "", resourceUrl: types.StringValue("https://example.com"), isFbiaResource: true},
+		{name: "bundle resource without incompatible attributes", resourceType: "bundle", resourceUrl: types.StringNull()},
+		{name: "bundle resource with resource_url", resourceType: "bundle", resourceUrl: types.StringValue("https://example.com"), expectedErrors: 1},
+		{name: "bundle resource with is_fbia_resource", resourceType: "bundle", resourceUrl: types.StringNull(), isFbiaResource: true, expectedWarnings: 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := validateResourceTypeCompatibility(c.resourceType, ResourceResourceModel{
+				ResourceUrl:    c.resourceUrl,
+				IsFbiaResource: types.BoolValue(c.isFbiaResource),
+			})
+			if diags.ErrorsCount() != c.expectedErrors || diags.WarningsCount() != c.expectedWarnings {
+				t.Errorf("expected %d errors and %d warnings, got %v", c.expectedErrors, c.expectedWarnings, diags)
+			}
+		})
+	}
+}
+
+func TestResourceResourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newFakePublisherClient()
+	r := &ResourceResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	planned := ResourceResourceWithTimeoutsModel{ResourceResourceModel: ResourceResourceModel{
+		Aid:             types.StringValue("example"),
+		Rid:             types.StringUnknown(),
+		Name:            types.StringValue("Premium"),
+		Description:     types.StringValue("Premium articles"),
+		Deleted:         types.BoolUnknown(),
+		Disabled:        types.BoolValue(false),
+		CreateDate:      types.Int64Unknown(),
+		UpdateDate:      types.Int64Unknown(),
+		PublishDate:     types.Int64Unknown(),
+		Published:       types.BoolNull(),
+		Type:            types.StringUnknown(),
+		TypeLabel:       types.StringUnknown(),
+		BundleType:      types.StringUnknown(),
+		BundleTypeLabel: types.StringUnknown(),
+		PurchaseUrl:     types.StringUnknown(),
+		IsFbiaResource:  types.BoolValue(false),
+	}, Timeouts: timeoutsNull()}
+	plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	var created ResourceResourceWithTimeoutsModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &created)...)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if _, ok := client.resources["example/"+created.Rid.ValueString()]; !ok {
+		t.Fatalf("expected resource %s to be created, got %v", created.Rid, client.resources)
+	}
+	if created.Description.ValueString() != "Premium articles" || created.Type.ValueString() != "standard" {
+		t.Errorf("expected attributes from the response, got %s %s", created.Description, created.Type)
+	}
+	if created.CreateDateIso.ValueString() != "2024-12-31T15:00:00Z" || !created.PublishDateIso.IsNull() {
+		t.Errorf("expected create_date_iso and publish_date_iso from the dates, got %s %s", created.CreateDateIso, created.PublishDateIso)
+	}
+
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	// The `*_iso` attributes follow date_timezone of the provider.
+	r.dateLocation = time.FixedZone("Asia/Tokyo", 9*60*60)
+	localResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &localResp)
+	var local ResourceResourceWithTimeoutsModel
+	localResp.Diagnostics.Append(localResp.State.Get(ctx, &local)...)
+	if localResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", localResp.Diagnostics)
+	}
+	if local.CreateDateIso.ValueString() != "2025-01-01T00:00:00+09:00" {
+		t.Errorf("expected create_date_iso in date_timezone, got %s", local.CreateDateIso)
+	}
+	r.dateLocation = nil
+
+	updated := created
+	updated.Name = types.StringValue("Premium Plus")
+	updated.UpdateDate = types.Int64Unknown()
+	plan = newTestPlan(t, schemaResp.Schema, nil)
+	plan = newTestPlan(t, schemaResp.Schema, &updated)
+	updateResp := resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: readResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	if actual := client.resources["example/"+created.Rid.ValueString()].Name; actual != "Premium Plus" {
+		t.Errorf("expected name to be updated, got %s", actual)
+	}
+
+	deleteResp := resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if len(client.resources) != 0 {
+		t.Errorf("expected resource to be deleted, got %v", client.resources)
+	}
+}
+
+func TestResourceResourceCreateDisabled(t *testing.T) {
+	ctx := context.Background()
+	client := newFakePublisherClient()
+	r := &ResourceResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	planned := ResourceResourceWithTimeoutsModel{ResourceResourceModel: ResourceResourceModel{
+		Aid:             types.StringValue("example"),
+		Rid:             types.StringUnknown(),
+		Name:            types.StringValue("Retired"),
+		Description:     types.StringNull(),
+		Deleted:         types.BoolUnknown(),
+		Disabled:        types.BoolValue(true),
+		CreateDate:      types.Int64Unknown(),
+		UpdateDate:      types.Int64Unknown(),
+		PublishDate:     types.Int64Unknown(),
+		Published:       types.BoolNull(),
+		Type:            types.StringUnknown(),
+		TypeLabel:       types.StringUnknown(),
+		BundleType:      types.StringUnknown(),
+		BundleTypeLabel: types.StringUnknown(),
+		PurchaseUrl:     types.StringUnknown(),
+		ExternalId:      types.StringValue("retired"),
+		IsFbiaResource:  types.BoolValue(false),
+	}, Timeouts: timeoutsNull()}
+	plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	expectedCalls := []string{"PostPublisherResourceCreate", "PostPublisherResourceUpdate"}
+	if fmt.Sprint(client.calls) != fmt.Sprint(expectedCalls) {
+		t.Errorf("expected calls %v, got %v", expectedCalls, client.calls)
+	}
+	var actual ResourceResourceWithTimeoutsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !actual.Disabled.ValueBool() || actual.ExternalId.ValueString() != "retired" {
+		t.Errorf("expected the planned values to be applied after creation, got disabled=%s external_id=%s", actual.Disabled, actual.ExternalId)
+	}
+	if data := client.resources["example/"+actual.Rid.ValueString()]; !data.Disabled {
+		t.Errorf("expected resource %s to be disabled", actual.Rid)
+	}
+}
+
+func TestCheckResourceDisabled(t *testing.T) {
+	diags := diag.Diagnostics{}
+	checkResourceDisabled(types.BoolValue(true), true, &diags)
+	checkResourceDisabled(types.BoolNull(), false, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	checkResourceDisabled(types.BoolValue(true), false, &diags)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Unexpected Disabled State" {
+		t.Errorf("expected an error for the resource left enabled, got %v", diags)
+	}
+}
+
+func TestResourceResourceCreateClientError(t *testing.T) {
+	ctx := context.Background()
+	client := newFakePublisherClient()
+	client.errors["PostPublisherResourceCreate"] = errors.New("connection refused")
+	r := &ResourceResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &ResourceResourceWithTimeoutsModel{ResourceResourceModel: ResourceResourceModel{
+		Aid:  types.StringValue("example"),
+		Name: types.StringValue("Premium"),
+	}, Timeouts: timeoutsNull()})
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "connection refused") {
+		t.Fatalf("expected the client error to be reported, got %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected no state to be saved, got %v", resp.State.Raw)
+	}
+	if len(client.calls) != 1 {
+		t.Errorf("expected no request after the error, got %v", client.calls)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceListTypes are the resource types listed when type is not set.
+// piano.io API requires type to list resources, so each type is listed in turn.
+var resourceListTypes = []piano_publisher.GetPublisherResourceListParamsType{
+	piano_publisher.GetPublisherResourceListParamsTypeStandard,
+	piano_publisher.GetPublisherResourceListParamsTypeBundle,
+	piano_publisher.GetPublisherResourceListParamsTypePrint,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ResourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ResourcesDataSource{}
+)
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &ResourcesDataSource{}
+}
+
+// ResourcesDataSource defines the data source implementation.
+type ResourcesDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+// ResourcesDataSourceModel describes the data source data model.
+type ResourcesDataSourceModel struct {
+	Aid       types.String              `tfsdk:"aid"`       // The application ID
+	Type      types.String              `tfsdk:"type"`      // The resource type to filter by
+	Disabled  types.Bool                `tfsdk:"disabled"`  // Whether to list disabled or enabled resources
+	Resources []ResourceDataSourceModel `tfsdk:"resources"` // The resources of the application
+}
+
+func (*ResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (*ResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resources data source. This data source is used to list resources of an application.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				MarkdownDescription: "The application ID",
+				Required:            true,
+				Validators:          aidValidators(),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The resource type. Only the resources of this type are listed when this value is set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("standard", "bundle", "print"),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Only the disabled resources are listed when this value is true and only the enabled ones when false. " +
+					"All the resources are listed when this value is null.",
+				Optional: true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The resources of the application",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rid": schema.StringAttribute{
+							MarkdownDescription: "The resource ID",
+							Computed:            true,
+						},
+						"aid": schema.StringAttribute{
+							MarkdownDescription: "The application ID",
+							Computed:            true,
+						},
+						"deleted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the object is deleted",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the object is disabled",
+							Computed:            true,
+						},
+						"create_date": schema.Int64Attribute{
+							MarkdownDescription: "The creation date timestamp",
+							Computed:            true,
+						},
+						"update_date": schema.Int64Attribute{
+							MarkdownDescription: "The update date timestamp",
+							Computed:            true,
+						},
+						"publish_date": schema.Int64Attribute{
+							MarkdownDescription: "The publish date timestamp",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The resource description",
+							Computed:            true,
+						},
+						"image_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the resource image",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the resource (0: Standard, 4: Bundle)",
+							Computed:            true,
+						},
+						"type_label": schema.StringAttribute{
+							MarkdownDescription: "The resource type label ('Standard' or 'Bundle')",
+							Computed:            true,
+						},
+						"bundle_type": schema.StringAttribute{
+							MarkdownDescription: "The resource bundle type",
+							Computed:            true,
+						},
+						"bundle_type_label": schema.StringAttribute{
+							MarkdownDescription: "The bundle type label",
+							Computed:            true,
+						},
+						"purchase_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the purchase page",
+							Computed:            true,
+						},
+						"resource_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the resource",
+							Computed:            true,
+						},
+						"external_id": schema.StringAttribute{
+							MarkdownDescription: "The external ID; defined by the client",
+							Computed:            true,
+						},
+						"is_fbia_resource": schema.BoolAttribute{
+							MarkdownDescription: "Enable the resource for Facebook Subscriptions in Instant Articles",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.publisherClient
+}
+
+func (d *ResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ResourcesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceTypes := resourceListTypes
+	if !state.Type.IsNull() {
+		resourceTypes = []piano_publisher.GetPublisherResourceListParamsType{piano_publisher.GetPublisherResourceListParamsType(state.Type.ValueString())}
+	}
+
+	entries := []ResourceDataSourceModel{}
+	for _, resourceType := range resourceTypes {
+		data, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.Resource, int, error) {
+			params := piano_publisher.GetPublisherResourceListParams{
+				Aid:            state.Aid.ValueString(),
+				Type:           resourceType,
+				Disabled:       state.Disabled.ValueBoolPointer(),
+				OrderBy:        piano_publisher.GetPublisherResourceListParamsOrderByRid,
+				OrderDirection: piano_publisher.GetPublisherResourceListParamsOrderDirectionAsc,
+				Offset:         int32(offset),
+				Limit:          int32(limit),
+			}
+			tflog.Debug(ctx, fmt.Sprintf("fetching %s resources in %s (offset: %d, limit: %d)", params.Type, params.Aid, params.Offset, params.Limit))
+			response, err := d.client.GetPublisherResourceList(ctx, &params)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch resources, got error: %s", err))
+				return nil, 0, err
+			}
+			anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			result := piano_publisher.ResourceArrayResult{}
+			err = json.Unmarshal(anyResponse.Raw, &result)
+			if err != nil {
+				resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+				return nil, 0, err
+			}
+			return result.Resources, syntax.TotalFrom(anyResponse), nil
+		})
+		if err != nil {
+			return
+		}
+		for _, resource := range data {
+			entries = append(entries, ResourceDataSourceModelFrom(resource))
+		}
+	}
+
+	state.Resources = entries
+	tflog.Trace(ctx, "read a resources data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourcesDataSourceRead(t *testing.T) {
+	// 120 standard resources over two pages and a bundle resource.
+	totals := map[string]int{"standard": 120, "bundle": 1, "print": 0}
+	cases := []struct {
+		name          string
+		resourceType  types.String
+		disabled      types.Bool
+		expectedTypes []string
+		expectedCount int
+	}{
+		{name: "all types", resourceType: types.StringNull(), disabled: types.BoolNull(), expectedTypes: []string{"standard", "bundle", "print"}, expectedCount: 121},
+		{name: "bundle", resourceType: types.StringValue("bundle"), disabled: types.BoolNull(), expectedTypes: []string{"bundle"}, expectedCount: 1},
+		{name: "enabled", resourceType: types.StringValue("standard"), disabled: types.BoolValue(false), expectedTypes: []string{"standard"}, expectedCount: 120},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			requestedTypes := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/resource/list" {
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+				query := req.URL.Query()
+				if query.Get("aid") != "example" || query.Get("order_by") != "rid" || query.Get("order_direction") != "asc" {
+					t.Errorf("expected aid and order to be sent, got %s", req.URL.RawQuery)
+				}
+				expectedDisabled := ""
+				if !c.disabled.IsNull() {
+					expectedDisabled = strconv.FormatBool(c.disabled.ValueBool())
+				}
+				if actual := query.Get("disabled"); actual != expectedDisabled {
+					t.Errorf("expected disabled %q, got %q", expectedDisabled, actual)
+				}
+				resourceType := query.Get("type")
+				offset, _ := strconv.Atoi(query.Get("offset"))
+				limit, _ := strconv.Atoi(query.Get("limit"))
+				if offset == 0 {
+					requestedTypes = append(requestedTypes, resourceType)
+				}
+				resources := []map[string]any{}
+				for i := offset; i < min(offset+limit, totals[resourceType]); i++ {
+					resources = append(resources, map[string]any{"aid": "example", "rid": fmt.Sprintf("R%s%d", resourceType, i), "name": "Resource", "type": resourceType})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "resources": resources, "total": totals[resourceType]}); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &ResourcesDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &ResourcesDataSourceModel{
+				Aid:      types.StringValue("example"),
+				Type:     c.resourceType,
+				Disabled: c.disabled,
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual ResourcesDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(requestedTypes, c.expectedTypes) {
+				t.Errorf("expected the resource types %v to be listed, got %v", c.expectedTypes, requestedTypes)
+			}
+			if len(actual.Resources) != c.expectedCount {
+				t.Fatalf("expected %d resources, got %d", c.expectedCount, len(actual.Resources))
+			}
+			if actual.Resources[0].Rid.ValueString() != fmt.Sprintf("R%s0", c.expectedTypes[0]) {
+				t.Errorf("expected the resources in order, got %s first", actual.Resources[0].Rid)
+			}
+		})
+	}
+}
+
+func TestResourceDataSourceModelFrom(t *testing.T) {
+	description := "Monthly bundle"
+	bundleType := piano_publisher.ResourceBundleTypeTagged
+	actual := ResourceDataSourceModelFrom(piano_publisher.Resource{
+		Aid:            "example",
+		Rid:            "RBUNDLE",
+		Name:           "Bundle",
+		Description:    &description,
+		Type:           piano_publisher.ResourceTypeBundle,
+		TypeLabel:      piano_publisher.ResourceTypeLabelBundle,
+		BundleType:     &bundleType,
+		Disabled:       true,
+		CreateDate:     1735657200,
+		UpdateDate:     1735657300,
+		PublishDate:    1735657400,
+		IsFbiaResource: true,
+	})
+	expected := ResourceDataSourceModel{
+		Rid:             types.StringValue("RBUNDLE"),
+		Aid:             types.StringValue("example"),
+		Deleted:         types.BoolValue(false),
+		Disabled:        types.BoolValue(true),
+		CreateDate:      types.Int64Value(1735657200),
+		UpdateDate:      types.Int64Value(1735657300),
+		PublishDate:     types.Int64Value(1735657400),
+		Name:            types.StringValue("Bundle"),
+		Description:     types.StringValue("Monthly bundle"),
+		ImageUrl:        types.StringNull(),
+		Type:            types.StringValue("bundle"),
+		TypeLabel:       types.StringValue("Bundle"),
+		BundleType:      types.StringValue("tagged"),
+		BundleTypeLabel: types.StringNull(),
+		PurchaseUrl:     types.StringNull(),
+		ResourceUrl:     types.StringNull(),
+		ExternalId:      types.StringNull(),
+		IsFbiaResource:  types.BoolValue(true),
+	}
+	if actual != expected {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
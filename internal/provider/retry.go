@@ -0,0 +1,40 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultMaxRetries is the number of retries when max_retries is not set in the provider.
+const defaultMaxRetries = 5
+
+// retryBaseBackoff is the wait before the first retry. It doubles on each retry.
+var retryBaseBackoff = 500 * time.Millisecond
+
+// retryUntilFound calls fetch until it reports found or maxRetries retries are exhausted.
+// piano.io API is eventually consistent, so an object created a moment ago can be reported as not found.
+// fetch should report true on errors other than not found to stop retrying.
+func retryUntilFound(ctx context.Context, maxRetries int, fetch func() bool) bool {
+	backoff := retryBaseBackoff
+	for attempt := 0; ; attempt++ {
+		if fetch() {
+			return true
+		}
+		if attempt >= maxRetries {
+			return false
+		}
+		tflog.Debug(ctx, fmt.Sprintf("object not found, retrying in %s (retry %d of %d)", backoff, attempt+1, maxRetries))
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
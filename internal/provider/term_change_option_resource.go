@@ -13,10 +13,13 @@ import (
 	"terraform-provider-piano/internal/syntax"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,11 +33,13 @@ type TermChangeOptionV2ResourceModel struct {
 	BillingTiming      types.String `tfsdk:"billing_timing"` // The billing timing
 	ImmediateAccess    types.Bool   `tfsdk:"immediate_access"`
 	ProrateAccess      types.Bool   `tfsdk:"prorate_access"`
+	IncludeTrial       types.Bool   `tfsdk:"include_trial"`
 	Description        types.String `tfsdk:"description"` // The description
 }
 
 var (
 	_ resource.Resource                = &TermChangeOptionResource{}
+	_ resource.ResourceWithModifyPlan  = &TermChangeOptionResource{}
 	_ resource.ResourceWithImportState = &TermChangeOptionResource{}
 )
 
@@ -44,7 +49,9 @@ func NewTermChangeOptionResource() resource.Resource {
 
 // TermDataSource defines the data source implementation.
 type TermChangeOptionResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	maxRetries int
 }
 
 func (r *TermChangeOptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,32 +59,26 @@ func (r *TermChangeOptionResource) Metadata(ctx context.Context, req resource.Me
 }
 
 func (r *TermChangeOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
 	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.maxRetries = client.maxRetries
+}
+
+func (r *TermChangeOptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
 }
 
 func (*TermChangeOptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Payment Term Change Option resource.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"term_change_option_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The term change option ID",
@@ -98,16 +99,30 @@ func (*TermChangeOptionResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "The billing timing",
 			},
 			"immediate_access": schema.BoolAttribute{
-				Computed:            true,
-				Optional:            true,
-				Default:             booldefault.StaticBool(false),
-				MarkdownDescription: "Immediate access flag",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+				// piano.io API does not provide an endpoint to update a term change option
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Whether the access begins immediately",
 			},
 			"prorate_access": schema.BoolAttribute{
-				Computed:            true,
-				Optional:            true,
-				Default:             booldefault.StaticBool(false),
-				MarkdownDescription: "Prorate access flag",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Whether the <a href=\"https://docs.piano.io/upgrades/?paragraphId=b27954ef84407e4#prorate-billing-amount\">Prorate billing amount</a> function is enabled. This value can be enabled only when the term to change from is a subscription.",
+			},
+			"include_trial": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Whether trial is enabled (not in use, always false)",
 			},
 			"description": schema.StringAttribute{
 				Optional:            true,
@@ -124,6 +139,30 @@ func (r *TermChangeOptionResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if plan.ProrateAccess.ValueBool() {
+		// The term may have been created in the same apply and not be visible yet.
+		var fromTerm *piano_publisher.Term
+		found := retryUntilFound(ctx, r.maxRetries, func() bool {
+			var found bool
+			fromTerm, found = r.fetchFromTerm(ctx, plan.FromTermId.ValueString(), &resp.Diagnostics)
+			return found || resp.Diagnostics.HasError()
+		})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddAttributeError(path.Root("from_term_id"), "Term Not Found", fmt.Sprintf("Term %s not found", plan.FromTermId.ValueString()))
+			return
+		}
+		if !isSubscriptionTerm(fromTerm.Type, fromTerm.PaymentIsSubscription) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prorate_access"),
+				"Invalid Prorate Access",
+				fmt.Sprintf("prorate_access can be enabled only when the term to change from is a subscription, but Term %s is not.", plan.FromTermId.ValueString()),
+			)
+			return
+		}
+	}
 	response, err := r.client.PostPublisherTermChangeOptionCreateWithFormdataBody(ctx, piano_publisher.PostPublisherTermChangeOptionCreateFormdataRequestBody{
 		Aid:             plan.Aid.ValueString(),
 		FromTermId:      plan.FromTermId.ValueString(),
@@ -151,6 +190,7 @@ func (r *TermChangeOptionResource) Create(ctx context.Context, req resource.Crea
 	option := TermChangeOptionV2ResourceModelFrom(result.TermChangeOption)
 	tflog.Info(ctx, fmt.Sprintf("created Term Change Option:%s from %s to %s", option.TermChangeOptionId.ValueString(), option.FromTermId.ValueString(), option.ToTermId.ValueString()))
 	plan.TermChangeOptionId = option.TermChangeOptionId
+	plan.IncludeTrial = option.IncludeTrial
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
 }
@@ -168,45 +208,32 @@ func (r *TermChangeOptionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	response, err := r.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
-		TermId: state.FromTermId.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch term, got error: %s", err))
-		return
-	}
-	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
-	if err != nil {
+	data, found := r.fetchFromTerm(ctx, state.FromTermId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	result := piano_publisher.TermResult{}
-	err = json.Unmarshal(anyResponse.Raw, &result)
-	if err != nil {
-		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Term %s not found. Removing Term Change Option %s from state", state.FromTermId.ValueString(), state.TermChangeOptionId.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	data := result.Term
-	state.Aid = types.StringValue(data.Aid)
-	var ExpectedTermChangeOption *piano_publisher.TermChangeOption
-	for _, termChangeOption := range data.ChangeOptions {
-		if termChangeOption.TermChangeOptionId == state.TermChangeOptionId.ValueString() {
-			ExpectedTermChangeOption = &termChangeOption
-			break
-		}
-	}
+	ExpectedTermChangeOption := TermChangeOptionFrom(*data, state.TermChangeOptionId.ValueString())
 	if ExpectedTermChangeOption == nil {
-		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Term Change Option %s not found in Term %s", state.TermChangeOptionId.ValueString(), state.FromTermId.ValueString()))
+		tflog.Warn(ctx, fmt.Sprintf("Term Change Option %s not found in Term %s. Removing it from state", state.TermChangeOptionId.ValueString(), state.FromTermId.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
 	state.TermChangeOptionId = types.StringValue(ExpectedTermChangeOption.TermChangeOptionId)
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
+	}
 	state.Aid = types.StringValue(data.Aid)
 	state.FromTermId = types.StringValue(ExpectedTermChangeOption.FromTermId)
 	state.ToTermId = types.StringValue(ExpectedTermChangeOption.ToTermId)
 	state.BillingTiming = types.StringValue(string(ExpectedTermChangeOption.BillingTiming))
 	state.ImmediateAccess = types.BoolValue(ExpectedTermChangeOption.ImmediateAccess)
 	state.ProrateAccess = types.BoolValue(ExpectedTermChangeOption.ProrateAccess)
+	state.IncludeTrial = types.BoolValue(ExpectedTermChangeOption.IncludeTrial)
 	if ExpectedTermChangeOption.Description != "" {
 		state.Description = types.StringValue(ExpectedTermChangeOption.Description)
 	}
@@ -217,7 +244,74 @@ func (r *TermChangeOptionResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *TermChangeOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	tflog.Warn(ctx, "deleting a resource is not supported for Term Change Option resource")
+	var state TermChangeOptionV2ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, found := r.fetchFromTerm(ctx, state.FromTermId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// change options are deleted together with their term
+		tflog.Info(ctx, fmt.Sprintf("Term %s has already been deleted with Term Change Option %s", state.FromTermId.ValueString(), state.TermChangeOptionId.ValueString()))
+		return
+	}
+	if TermChangeOptionFrom(*data, state.TermChangeOptionId.ValueString()) == nil {
+		tflog.Info(ctx, fmt.Sprintf("Term Change Option %s has already been deleted from Term %s", state.TermChangeOptionId.ValueString(), state.FromTermId.ValueString()))
+		return
+	}
+	// piano.io API does not provide an endpoint to delete a term change option, so there is no response for syntax.DeletedResponseFrom.
+	// The change options already deleted with or from their term are tolerated above instead.
+	resp.Diagnostics.AddWarning(
+		"Term Change Option Not Deleted",
+		fmt.Sprintf("piano.io API does not support deleting Term Change Option %s. It is removed from terraform state, but remains in Term %s until it is deleted in piano.io dashboard or the term is deleted.", state.TermChangeOptionId.ValueString(), state.FromTermId.ValueString()),
+	)
+}
+
+// fetchFromTerm fetches the term the change option belongs to. It reports false when the term does not exist.
+func (r *TermChangeOptionResource) fetchFromTerm(ctx context.Context, termId string, diagnostics *diag.Diagnostics) (*piano_publisher.Term, bool) {
+	response, err := r.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
+		TermId: termId,
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch term, got error: %s", err))
+		return nil, false
+	}
+	anyResponse, err := syntax.AnyResponseFrom(response, diagnostics)
+	if err != nil {
+		return nil, false
+	}
+	if errors.Is(anyResponse.Err(), syntax.ErrNotFound) {
+		return nil, false
+	}
+	if anyResponse.Code != 0 {
+		syntax.AddStatusError(anyResponse, diagnostics)
+		return nil, false
+	}
+
+	result := piano_publisher.TermResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return nil, false
+	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, diagnostics) {
+		return nil, false
+	}
+	return &result.Term, true
+}
+
+// TermChangeOptionFrom finds the change option in the term. It returns nil when the term does not have the change option.
+func TermChangeOptionFrom(term piano_publisher.Term, termChangeOptionId string) *piano_publisher.TermChangeOption {
+	for _, termChangeOption := range term.ChangeOptions {
+		if termChangeOption.TermChangeOptionId == termChangeOptionId {
+			return &termChangeOption
+		}
+	}
+	return nil
 }
 
 func (r *TermChangeOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -253,5 +347,6 @@ func TermChangeOptionV2ResourceModelFrom(data piano_publisher.TermChangeOption)
 	ret.ImmediateAccess = types.BoolValue(data.ImmediateAccess)
 	ret.ToTermId = types.StringValue(data.ToTermId)
 	ret.ProrateAccess = types.BoolValue(data.ProrateAccess)
+	ret.IncludeTrial = types.BoolValue(data.IncludeTrial)
 	return ret
 }
@@ -0,0 +1,214 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTermChangeOptionResourceDelete(t *testing.T) {
+	cases := []struct {
+		name            string
+		body            string
+		expectedWarning bool
+	}{
+		{
+			name: "parent term already deleted",
+			body: `{"code":1001,"message":"Term not found"}`,
+		},
+		{
+			name: "change option already removed from the term",
+			body: `{"code":0,"term":{"aid":"example","term_id":"TMFROM","change_options":[]}}`,
+		},
+		{
+			name:            "change option remaining in the term",
+			body:            `{"code":0,"term":{"aid":"example","term_id":"TMFROM","change_options":[{"term_change_option_id":"TCO1","from_term_id":"TMFROM","to_term_id":"TMTO"}]}}`,
+			expectedWarning: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/term/get" || req.URL.Query().Get("term_id") != "TMFROM" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, c.body)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &TermChangeOptionResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, &TermChangeOptionV2ResourceModel{
+				TermChangeOptionId: types.StringValue("TCO1"),
+				Aid:                types.StringValue("example"),
+				FromTermId:         types.StringValue("TMFROM"),
+				ToTermId:           types.StringValue("TMTO"),
+				BillingTiming:      types.StringValue("immediate"),
+				ImmediateAccess:    types.BoolValue(false),
+				ProrateAccess:      types.BoolValue(false),
+				Description:        types.StringNull(),
+			})
+
+			resp := resource.DeleteResponse{}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if actual := resp.Diagnostics.WarningsCount() > 0; actual != c.expectedWarning {
+				t.Errorf("expected warning: %t, got %v", c.expectedWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTermChangeOptionResourceCreateAccessFlags(t *testing.T) {
+	cases := []struct {
+		name            string
+		immediateAccess bool
+		prorateAccess   bool
+		subscription    bool
+		expectedError   bool
+	}{
+		{name: "immediate access only", immediateAccess: true, subscription: false},
+		{name: "prorate access for subscription", prorateAccess: true, subscription: true},
+		{name: "immediate and prorate access for subscription", immediateAccess: true, prorateAccess: true, subscription: true},
+		{name: "prorate access for one-off term", prorateAccess: true, subscription: false, expectedError: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			created := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch req.URL.Path {
+				case "/publisher/term/get":
+					fmt.Fprintf(w, `{"code":0,"term":{"aid":"example","term_id":"TMFROM","type":"payment","payment_is_subscription":%t}}`, c.subscription)
+				case "/publisher/term/change/option/create":
+					created = true
+					if err := req.ParseForm(); err != nil {
+						t.Fatal(err)
+					}
+					if actual := req.PostForm.Get("immediate_access"); actual != fmt.Sprint(c.immediateAccess) {
+						t.Errorf("expected immediate_access %t, got %s", c.immediateAccess, actual)
+					}
+					if actual := req.PostForm.Get("prorate_access"); actual != fmt.Sprint(c.prorateAccess) {
+						t.Errorf("expected prorate_access %t, got %s", c.prorateAccess, actual)
+					}
+					fmt.Fprintf(w, `{"code":0,"term_change_option":{"term_change_option_id":"TCO1","from_term_id":"TMFROM","to_term_id":"TMTO","billing_timing":"0","immediate_access":%t,"prorate_access":%t,"include_trial":false}}`, c.immediateAccess, c.prorateAccess)
+				default:
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &TermChangeOptionResource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			plan := newTestPlan(t, schemaResp.Schema, &TermChangeOptionV2ResourceModel{
+				TermChangeOptionId: types.StringUnknown(),
+				Aid:                types.StringValue("example"),
+				FromTermId:         types.StringValue("TMFROM"),
+				ToTermId:           types.StringValue("TMTO"),
+				BillingTiming:      types.StringValue("0"),
+				ImmediateAccess:    types.BoolValue(c.immediateAccess),
+				ProrateAccess:      types.BoolValue(c.prorateAccess),
+				IncludeTrial:       types.BoolUnknown(),
+				Description:        types.StringNull(),
+			})
+
+			resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Fatalf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if created == c.expectedError {
+				t.Errorf("expected change option to be created: %t", !c.expectedError)
+			}
+			if c.expectedError {
+				return
+			}
+			var actual TermChangeOptionV2ResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if actual.ImmediateAccess.ValueBool() != c.immediateAccess || actual.ProrateAccess.ValueBool() != c.prorateAccess || actual.IncludeTrial.ValueBool() {
+				t.Errorf("unexpected state: %v", actual)
+			}
+		})
+	}
+}
+
+func TestTermChangeOptionResourceCreateRetriesTermNotFound(t *testing.T) {
+	ctx := context.Background()
+	backoff := retryBaseBackoff
+	retryBaseBackoff = 0
+	defer func() { retryBaseBackoff = backoff }()
+
+	fetched := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/publisher/term/get":
+			fetched++
+			if fetched == 1 {
+				fmt.Fprint(w, `{"code":1001,"message":"Term not found"}`)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMFROM","type":"payment","payment_is_subscription":true}}`)
+		case "/publisher/term/change/option/create":
+			fmt.Fprint(w, `{"code":0,"term_change_option":{"term_change_option_id":"TCO1","from_term_id":"TMFROM","to_term_id":"TMTO","billing_timing":"0","immediate_access":false,"prorate_access":true,"include_trial":false}}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &TermChangeOptionResource{client: client, maxRetries: defaultMaxRetries}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &TermChangeOptionV2ResourceModel{
+		TermChangeOptionId: types.StringUnknown(),
+		Aid:                types.StringValue("example"),
+		FromTermId:         types.StringValue("TMFROM"),
+		ToTermId:           types.StringValue("TMTO"),
+		BillingTiming:      types.StringValue("0"),
+		ImmediateAccess:    types.BoolValue(false),
+		ProrateAccess:      types.BoolValue(true),
+		IncludeTrial:       types.BoolUnknown(),
+		Description:        types.StringNull(),
+	})
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if fetched != 2 {
+		t.Errorf("expected the term to be fetched twice, got %d", fetched)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &TermChangeOptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TermChangeOptionsDataSource{}
+)
+
+// TermChangeOptionsDataSource defines the data source implementation.
+type TermChangeOptionsDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+func NewTermChangeOptionsDataSource() datasource.DataSource {
+	return &TermChangeOptionsDataSource{}
+}
+
+// TermChangeOptionsDataSourceModel describes the data source data model.
+type TermChangeOptionsDataSourceModel struct {
+	Aid           types.String                      `tfsdk:"aid"`            // The application ID
+	TermId        types.String                      `tfsdk:"term_id"`        // The term ID
+	ChangeOptions []TermChangeOptionDataSourceModel `tfsdk:"change_options"` // The change options of the term
+}
+
+func (d *TermChangeOptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.publisherClient
+}
+
+func (d *TermChangeOptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_term_change_options"
+}
+
+func (*TermChangeOptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Term change options data source. This data source is used to list the upgrade and downgrade options from a term.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
+			},
+			"term_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The term ID",
+			},
+			"change_options": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The change options of the term sorted by `term_change_option_id`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: termChangeOptionComputedAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read lists the change options embedded in the term, as piano.io API has no endpoint to list change options.
+func (d *TermChangeOptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TermChangeOptionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
+		TermId: state.TermId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch term, got error: %s", err))
+		return
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+
+	result := piano_publisher.TermResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return
+	}
+	if !AidMatches(state.Aid, result.Term.Aid, &resp.Diagnostics) {
+		return
+	}
+
+	changeOptions := []TermChangeOptionDataSourceModel{}
+	for _, element := range sortedTermChangeOptions(result.Term.ChangeOptions) {
+		changeOptions = append(changeOptions, TermChangeOptionDataSourceModelFrom(element))
+	}
+	state.ChangeOptions = changeOptions
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
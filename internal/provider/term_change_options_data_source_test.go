@@ -0,0 +1,85 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTermChangeOptionsDataSourceRead(t *testing.T) {
+	cases := []struct {
+		name        string
+		aid         string
+		expectError bool
+	}{
+		{name: "change options are sorted by id", aid: "example"},
+		{name: "term in another application", aid: "other", expectError: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodGet || req.URL.Path != "/publisher/term/get" {
+					t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+				}
+				if termId := req.URL.Query().Get("term_id"); termId != "TMXXXXXXX" {
+					t.Errorf("expected term_id=TMXXXXXXX, got %s", termId)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXXX","type":"payment","change_options":[`+
+					`{"term_change_option_id":"TCO2","from_term_id":"TMXXXXXXX","to_term_id":"TMYYYYYYY","billing_timing":"1","upgrade_offers":[]},`+
+					`{"term_change_option_id":"TCO1","from_term_id":"TMXXXXXXX","to_term_id":"TMZZZZZZZ","billing_timing":"0","upgrade_offers":[{"offer_id":"OF1","name":"upgrade"}]}`+
+					`]}}`)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &TermChangeOptionsDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &TermChangeOptionsDataSourceModel{
+				Aid:    types.StringValue(c.aid),
+				TermId: types.StringValue("TMXXXXXXX"),
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if c.expectError {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Application ID Mismatch" {
+					t.Fatalf("expected application ID mismatch, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual TermChangeOptionsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if len(actual.ChangeOptions) != 2 {
+				t.Fatalf("expected 2 change options, got %d", len(actual.ChangeOptions))
+			}
+			first, second := actual.ChangeOptions[0], actual.ChangeOptions[1]
+			if first.TermChangeOptionId.ValueString() != "TCO1" || second.TermChangeOptionId.ValueString() != "TCO2" {
+				t.Errorf("expected change options sorted by id, got %s, %s", first.TermChangeOptionId, second.TermChangeOptionId)
+			}
+			if first.ToTermId.ValueString() != "TMZZZZZZZ" || len(first.UpgradeOffers) != 1 || first.UpgradeOffers[0].OfferId.ValueString() != "OF1" {
+				t.Errorf("unexpected change option: %+v", first)
+			}
+		})
+	}
+}
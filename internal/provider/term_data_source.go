@@ -7,13 +7,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -32,7 +35,7 @@ func NewTermDataSource() datasource.DataSource {
 
 // TermDataSource defines the data source implementation.
 type TermDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 type PeriodDataSourceModel struct {
@@ -109,13 +112,18 @@ type TermDataSourceModel struct {
 	ExternalProductIds                    types.String                             `tfsdk:"external_product_ids"`                         // <a href="https://docs.piano.io/linked-term/#external-product">“External products"</a> are entities of the external system accessed by users. If you enter multiple values (separated by a comma), Piano will create a standard resource for each product and also a bundled resource that will group them. Example: "digital_prod,print_sub_access,main_articles".
 	ExternalTermId                        types.String                             `tfsdk:"external_term_id"`                             // The ID of the term in the external system. Provided by the external system.
 	IsAllowedToChangeSchedulePeriodInPast types.Bool                               `tfsdk:"is_allowed_to_change_schedule_period_in_past"` // Whether the term allows to change its schedule period created previously
+	IsContract                            types.Bool                               `tfsdk:"is_contract"`                                  // Whether the term is a contract term
+	IsGift                                types.Bool                               `tfsdk:"is_gift"`                                      // Whether the term is a gift term
+	IsSubscription                        types.Bool                               `tfsdk:"is_subscription"`                              // Whether the term is a subscription
 	MaximumDaysInAdvance                  types.Int32                              `tfsdk:"maximum_days_in_advance"`                      // Maximum days in advance
 	Name                                  types.String                             `tfsdk:"name"`                                         // The term name
-	PaymentAllowGift                      types.Bool                               `tfsdk:"payment_allow_gift"`                           // Whether the term can be gifted
-	PaymentAllowPromoCodes                types.Bool                               `tfsdk:"payment_allow_promo_codes"`                    // Whether to allow promo codes to be applied
-	PaymentAllowRenewDays                 types.Int32                              `tfsdk:"payment_allow_renew_days"`                     // How many days in advance users user can renew
-	PaymentBillingPlan                    types.String                             `tfsdk:"payment_billing_plan"`                         // The billing plan for the term
-	PaymentBillingPlanDescription         types.String                             `tfsdk:"payment_billing_plan_description"`             // The description of the term billing plan
+	NextSellDate                          types.Int64                              `tfsdk:"next_sell_date"`                               // The nearest future sell date among the schedule periods
+	Offers                                []LightOfferDataSourceModel              `tfsdk:"offers"`
+	PaymentAllowGift                      types.Bool                               `tfsdk:"payment_allow_gift"`               // Whether the term can be gifted
+	PaymentAllowPromoCodes                types.Bool                               `tfsdk:"payment_allow_promo_codes"`        // Whether to allow promo codes to be applied
+	PaymentAllowRenewDays                 types.Int32                              `tfsdk:"payment_allow_renew_days"`         // How many days in advance users user can renew
+	PaymentBillingPlan                    types.String                             `tfsdk:"payment_billing_plan"`             // The billing plan for the term
+	PaymentBillingPlanDescription         types.String                             `tfsdk:"payment_billing_plan_description"` // The description of the term billing plan
 	PaymentBillingPlanTable               []PaymentBillingPlanTableDataSourceModel `tfsdk:"payment_billing_plan_table"`
 	PaymentCurrency                       types.String                             `tfsdk:"payment_currency"`                  // The currency of the term
 	PaymentFirstPrice                     types.Float64                            `tfsdk:"payment_first_price"`               // The first price of the term
@@ -143,7 +151,73 @@ type TermDataSourceModel struct {
 	UpdateDate                            types.Int64                              `tfsdk:"update_date"`                 // The update date
 	VerifyOnRenewal                       types.Bool                               `tfsdk:"verify_on_renewal"`           // Whether the term should be verified before renewal (if "FALSE", this step is skipped)
 	VoucheringPolicy                      *VoucheringPolicyDataSourceModel         `tfsdk:"vouchering_policy"`
+	Payment                               *TermPaymentDataSourceModel              `tfsdk:"payment"`      // The attributes of payment, dynamic and gift terms
+	External                              *TermExternalDataSourceModel             `tfsdk:"external"`     // The attributes of external terms
+	Registration                          *TermRegistrationDataSourceModel         `tfsdk:"registration"` // The attributes of registration terms
 }
+
+// TermPaymentDataSourceModel describes the attributes only meaningful for payment, dynamic and gift terms.
+type TermPaymentDataSourceModel struct {
+	PaymentAllowGift              types.Bool                               `tfsdk:"payment_allow_gift"`
+	PaymentAllowPromoCodes        types.Bool                               `tfsdk:"payment_allow_promo_codes"`
+	PaymentAllowRenewDays         types.Int32                              `tfsdk:"payment_allow_renew_days"`
+	PaymentBillingPlan            types.String                             `tfsdk:"payment_billing_plan"`
+	PaymentBillingPlanDescription types.String                             `tfsdk:"payment_billing_plan_description"`
+	PaymentBillingPlanTable       []PaymentBillingPlanTableDataSourceModel `tfsdk:"payment_billing_plan_table"`
+	PaymentCurrency               types.String                             `tfsdk:"payment_currency"`
+	PaymentFirstPrice             types.Float64                            `tfsdk:"payment_first_price"`
+	PaymentForceAutoRenew         types.Bool                               `tfsdk:"payment_force_auto_renew"`
+	PaymentHasFreeTrial           types.Bool                               `tfsdk:"payment_has_free_trial"`
+	PaymentIsCustomPriceAvailable types.Bool                               `tfsdk:"payment_is_custom_price_available"`
+	PaymentIsSubscription         types.Bool                               `tfsdk:"payment_is_subscription"`
+	PaymentNewCustomersOnly       types.Bool                               `tfsdk:"payment_new_customers_only"`
+	PaymentRenewGracePeriod       types.Int32                              `tfsdk:"payment_renew_grace_period"`
+	PaymentTrialNewCustomersOnly  types.Bool                               `tfsdk:"payment_trial_new_customers_only"`
+	Schedule                      *ScheduleDataSourceModel                 `tfsdk:"schedule"`
+	ScheduleBilling               types.String                             `tfsdk:"schedule_billing"`
+}
+
+// TermExternalDataSourceModel describes the attributes only meaningful for external terms.
+type TermExternalDataSourceModel struct {
+	EvtFixedTimeAccessPeriod types.Int32                       `tfsdk:"evt_fixed_time_access_period"`
+	EvtGooglePlayProductId   types.String                      `tfsdk:"evt_google_play_product_id"`
+	EvtGracePeriod           types.Int32                       `tfsdk:"evt_grace_period"`
+	EvtItunesBundleId        types.String                      `tfsdk:"evt_itunes_bundle_id"`
+	EvtItunesProductId       types.String                      `tfsdk:"evt_itunes_product_id"`
+	EvtVerificationPeriod    types.Int32                       `tfsdk:"evt_verification_period"`
+	ExternalApiFormFields    []ExternalAPIFieldDataSourceModel `tfsdk:"external_api_form_fields"`
+	ExternalApiId            types.String                      `tfsdk:"external_api_id"`
+	ExternalApiName          types.String                      `tfsdk:"external_api_name"`
+	ExternalApiSource        types.Int32                       `tfsdk:"external_api_source"`
+	SharedAccountCount       types.Int32                       `tfsdk:"shared_account_count"`
+	SharedRedemptionUrl      types.String                      `tfsdk:"shared_redemption_url"`
+}
+
+// TermRegistrationDataSourceModel describes the attributes only meaningful for registration terms.
+type TermRegistrationDataSourceModel struct {
+	RegistrationAccessPeriod types.Int32 `tfsdk:"registration_access_period"`
+	RegistrationGracePeriod  types.Int32 `tfsdk:"registration_grace_period"`
+}
+
+// termPaymentAttributes, termExternalAttributes and termRegistrationAttributes are the top-level attributes
+// repeated in the payment, external and registration blocks respectively.
+var (
+	termPaymentAttributes = []string{
+		"payment_allow_gift", "payment_allow_promo_codes", "payment_allow_renew_days", "payment_billing_plan",
+		"payment_billing_plan_description", "payment_billing_plan_table", "payment_currency", "payment_first_price",
+		"payment_force_auto_renew", "payment_has_free_trial", "payment_is_custom_price_available", "payment_is_subscription",
+		"payment_new_customers_only", "payment_renew_grace_period", "payment_trial_new_customers_only", "schedule", "schedule_billing",
+	}
+	termExternalAttributes = []string{
+		"evt_fixed_time_access_period", "evt_google_play_product_id", "evt_grace_period", "evt_itunes_bundle_id",
+		"evt_itunes_product_id", "evt_verification_period", "external_api_form_fields", "external_api_id",
+		"external_api_name", "external_api_source", "shared_account_count", "shared_redemption_url",
+	}
+	termRegistrationAttributes = []string{
+		"registration_access_period", "registration_grace_period",
+	}
+)
+
 type VoucheringPolicyDataSourceModel struct {
 	VoucheringPolicyBillingPlan            types.String `tfsdk:"vouchering_policy_billing_plan"`             // The billing plan of the vouchering policy
 	VoucheringPolicyBillingPlanDescription types.String `tfsdk:"vouchering_policy_billing_plan_description"` // The description of the vouchering policy billing plan
@@ -360,6 +434,23 @@ func (*TermDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "The term name",
 			},
+			"offers": schema.ListNestedAttribute{
+				Computed: true,
+				MarkdownDescription: "The offers which contain the term. " +
+					"piano.io API does not return offers with a term, so the offers of the application are listed with extra API calls to find them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"offer_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The offer ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The offer name",
+						},
+					},
+				},
+			},
 			"evt_itunes_product_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "iTunes's product ID",
@@ -395,131 +486,7 @@ func (*TermDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 			"change_options": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"from_resource_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the \"From\" resource",
-						},
-						"from_period_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the \"From\" term period",
-						},
-						"to_period_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The period name of the \"To\" term",
-						},
-						"prorate_access": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether the <a href=\"https://docs.piano.io/upgrades/?paragraphId=b27954ef84407e4#prorate-billing-amount\">Prorate billing amount</a> function is enabled",
-						},
-						"to_billing_plan": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The \"To\" billing plan",
-						},
-						"from_period_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The name of the \"From\" term period",
-						},
-						"from_term_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The name of the \"From\" term",
-						},
-						"advanced_options": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"show_options": schema.SetAttribute{
-									Computed:    true,
-									ElementType: basetypes.StringType{},
-								},
-							},
-						},
-						"term_change_option_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the term change option",
-						},
-						"to_term_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The name of the \"To\" term",
-						},
-						"to_term_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the \"To\" term",
-						},
-						"include_trial": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether trial is enabled (not in use, always \"FALSE\")",
-						},
-						"immediate_access": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether the access begins immediately",
-						},
-						"from_term_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the \"From\" term",
-						},
-						"from_billing_plan": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The \"From\" billing plan",
-						},
-						"upgrade_offers": schema.ListNestedAttribute{
-							Computed: true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"offer_id": schema.StringAttribute{
-										Computed:            true,
-										MarkdownDescription: "The offer ID",
-									},
-									"name": schema.StringAttribute{
-										Computed:            true,
-										MarkdownDescription: "The offer name",
-									},
-								},
-							},
-						},
-						"shared_account_count": schema.Int32Attribute{
-							Computed:            true,
-							MarkdownDescription: "The count of allowed shared-subscription accounts",
-						},
-						"from_resource_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The name of the \"From\" resource",
-						},
-						"description": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "A description of the term change option; provided by the client",
-						},
-						"from_scheduled": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether the subscription is upgraded from a scheduled term",
-						},
-						"billing_timing": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The billing timing(0: immediate term change;1: term change at the end of the current cycle;2: term change on the next sell date;3: term change at the end of the current period)",
-							Validators: []validator.String{
-								stringvalidator.OneOf("0", "1", "2", "3"),
-							},
-						},
-						"collect_address": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether to collect an address for this term",
-						},
-						"to_resource_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the \"To\" resource",
-						},
-						"to_period_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The ID of the \"To\" term period",
-						},
-						"to_resource_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The name of the \"To\" resource",
-						},
-						"to_scheduled": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether the subscription is upgraded to a scheduled term",
-						},
-					},
+					Attributes: termChangeOptionComputedAttributes(),
 				},
 			},
 			"shared_account_count": schema.Int32Attribute{
@@ -575,6 +542,7 @@ func (*TermDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 			"aid": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
 			},
 			"external_api_source": schema.Int32Attribute{
 				Computed:            true,
@@ -944,6 +912,22 @@ func (*TermDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 					stringvalidator.OneOf("payment", "adview", "registration", "newsletter", "external", "custom", "grant_access", "gift", "specific_email_addresses_contract", "email_domain_contract", "ip_range_contract", "dynamic", "linked"),
 				},
 			},
+			"is_gift": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the term is a gift term, i.e. `type` is `gift`. Use `payment_allow_gift` to check whether a payment term can be gifted.",
+			},
+			"is_contract": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the term is a contract term, i.e. `type` is one of `specific_email_addresses_contract`, `email_domain_contract` or `ip_range_contract`",
+			},
+			"is_subscription": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the term is a payment or dynamic term billed as a subscription (unlike one-off)",
+			},
+			"next_sell_date": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The nearest sell date in the future among the periods of `schedule`. Null when the term has no schedule or no period goes on sale in the future.",
+			},
 			"external_api_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The ID of the external API configuration",
@@ -1002,7 +986,33 @@ func (*TermDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 			},
 		},
 	}
+	attributes := resp.Schema.Attributes
+	attributes["payment"] = schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The attributes of payment, dynamic and gift terms. Null for other term types.",
+		Attributes:          termAttributesOf(attributes, termPaymentAttributes),
+	}
+	attributes["external"] = schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The attributes of external terms. Null for other term types.",
+		Attributes:          termAttributesOf(attributes, termExternalAttributes),
+	}
+	attributes["registration"] = schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The attributes of registration terms. Null for other term types.",
+		Attributes:          termAttributesOf(attributes, termRegistrationAttributes),
+	}
+}
+
+// termAttributesOf picks the attributes named names from attributes.
+func termAttributesOf(attributes map[string]schema.Attribute, names []string) map[string]schema.Attribute {
+	ret := map[string]schema.Attribute{}
+	for _, name := range names {
+		ret[name] = attributes[name]
+	}
+	return ret
 }
+
 func ExternalAPIFieldDataSourceModelFrom(data piano_publisher.ExternalAPIField) ExternalAPIFieldDataSourceModel {
 	ret := ExternalAPIFieldDataSourceModel{}
 	ret.Editable = types.StringValue(data.Editable)
@@ -1122,6 +1132,7 @@ func DeliveryZoneDataSourceModelFrom(data piano_publisher.DeliveryZone) Delivery
 	return ret
 }
 func PaymentBillingPlanTableDataSourceModelFrom(data piano_publisher.PaymentBillingPlanTable) PaymentBillingPlanTableDataSourceModel {
+	data = completeBillingPlanTablePrices(data)
 	ret := PaymentBillingPlanTableDataSourceModel{}
 	ret.IsTrial = types.StringPointerValue(data.IsTrial)
 	ret.Cycles = types.StringPointerValue(data.Cycles)
@@ -1196,18 +1207,9 @@ func ResourceDataSourceModelFrom(data piano_publisher.Resource) ResourceDataSour
 }
 
 func (d *TermDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *piano.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
@@ -1245,6 +1247,29 @@ func (d *TermDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	data := result.Term
 
+	// Only the block of the term type is set so that attributes of other term types do not show up as nulls.
+	state.Payment = nil
+	state.External = nil
+	state.Registration = nil
+	switch data.Type {
+	case piano_publisher.TermTypePayment, piano_publisher.TermTypeDynamic, piano_publisher.TermTypeGift:
+		state.Payment = TermPaymentDataSourceModelFrom(data)
+	case piano_publisher.TermTypeExternal:
+		// piano_publisher.Term types some attributes of external terms as optional, so decode it as ExternalTerm.
+		externalResult := piano_publisher.ExternalTermResult{}
+		err = json.Unmarshal(anyResponse.Raw, &externalResult)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return
+		}
+		state.External = TermExternalDataSourceModelFrom(externalResult.Term)
+	case piano_publisher.TermTypeRegistration:
+		state.Registration = &TermRegistrationDataSourceModel{
+			RegistrationAccessPeriod: types.Int32PointerValue(data.RegistrationAccessPeriod),
+			RegistrationGracePeriod:  types.Int32PointerValue(data.RegistrationGracePeriod),
+		}
+	}
+
 	state.ExternalTermId = types.StringPointerValue(data.ExternalTermId)
 	state.EvtItunesBundleId = types.StringPointerValue(data.EvtItunesBundleId)
 	state.PaymentRenewGracePeriod = types.Int32Value(data.PaymentRenewGracePeriod)
@@ -1255,6 +1280,9 @@ func (d *TermDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	state.ShowFullBillingPlan = types.BoolPointerValue(data.ShowFullBillingPlan)
 	state.ExternalApiId = types.StringPointerValue(data.ExternalApiId)
 	state.Type = types.StringValue(string(data.Type))
+	state.IsGift = types.BoolValue(isGiftTerm(data.Type))
+	state.IsContract = types.BoolValue(isContractTerm(data.Type))
+	state.IsSubscription = types.BoolValue(isSubscriptionTerm(data.Type, data.PaymentIsSubscription))
 	state.ProductCategory = types.StringValue(data.ProductCategory)
 	state.TypeName = types.StringValue(string(data.TypeName))
 	state.CurrencySymbol = types.StringValue(data.CurrencySymbol)
@@ -1265,6 +1293,7 @@ func (d *TermDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		Schedule := ScheduleDataSourceModelFrom(*data.Schedule)
 		state.Schedule = &Schedule
 	}
+	state.NextSellDate = nextSellDateFrom(data.Schedule, time.Now())
 	state.PaymentForceAutoRenew = types.BoolValue(data.PaymentForceAutoRenew)
 	state.PaymentAllowGift = types.BoolValue(data.PaymentAllowGift)
 	state.PaymentBillingPlan = types.StringValue(data.PaymentBillingPlan)
@@ -1299,14 +1328,14 @@ func (d *TermDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	state.Aid = types.StringValue(data.Aid)
 	if data.ExternalApiFormFields != nil {
 		externalApiFormFieldsElements := []ExternalAPIFieldDataSourceModel{}
-		for _, element := range *data.ExternalApiFormFields {
+		for _, element := range sortedExternalAPIFields(*data.ExternalApiFormFields) {
 			externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldDataSourceModelFrom(element))
 		}
 		state.ExternalApiFormFields = externalApiFormFieldsElements
 	}
 	state.SharedAccountCount = types.Int32PointerValue(data.SharedAccountCount)
 	changeOptionsElements := []TermChangeOptionDataSourceModel{}
-	for _, element := range data.ChangeOptions {
+	for _, element := range sortedTermChangeOptions(data.ChangeOptions) {
 		changeOptionsElements = append(changeOptionsElements, TermChangeOptionDataSourceModelFrom(element))
 	}
 	state.ChangeOptions = changeOptionsElements
@@ -1339,8 +1368,284 @@ func (d *TermDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	state.PaymentAllowPromoCodes = types.BoolValue(data.PaymentAllowPromoCodes)
 	state.Description = types.StringValue(data.Description)
 	state.PaymentAllowRenewDays = types.Int32Value(data.PaymentAllowRenewDays)
+	offers, ok := termOffersFrom(ctx, d.client, data.Aid, data.TermId, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	state.Offers = offers
 
 	tflog.Trace(ctx, "read a data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// TermPaymentDataSourceModelFrom extracts the attributes of payment, dynamic and gift terms.
+func TermPaymentDataSourceModelFrom(data piano_publisher.Term) *TermPaymentDataSourceModel {
+	ret := TermPaymentDataSourceModel{}
+	ret.PaymentAllowGift = types.BoolValue(data.PaymentAllowGift)
+	ret.PaymentAllowPromoCodes = types.BoolValue(data.PaymentAllowPromoCodes)
+	ret.PaymentAllowRenewDays = types.Int32Value(data.PaymentAllowRenewDays)
+	ret.PaymentBillingPlan = types.StringValue(data.PaymentBillingPlan)
+	ret.PaymentBillingPlanDescription = types.StringValue(data.PaymentBillingPlanDescription)
+	paymentBillingPlanTableElements := []PaymentBillingPlanTableDataSourceModel{}
+	for _, element := range data.PaymentBillingPlanTable {
+		paymentBillingPlanTableElements = append(paymentBillingPlanTableElements, PaymentBillingPlanTableDataSourceModelFrom(element))
+	}
+	ret.PaymentBillingPlanTable = paymentBillingPlanTableElements
+	ret.PaymentCurrency = types.StringValue(data.PaymentCurrency)
+	ret.PaymentFirstPrice = types.Float64Value(data.PaymentFirstPrice)
+	ret.PaymentForceAutoRenew = types.BoolValue(data.PaymentForceAutoRenew)
+	ret.PaymentHasFreeTrial = types.BoolValue(data.PaymentHasFreeTrial)
+	ret.PaymentIsCustomPriceAvailable = types.BoolValue(data.PaymentIsCustomPriceAvailable)
+	ret.PaymentIsSubscription = types.BoolValue(data.PaymentIsSubscription)
+	ret.PaymentNewCustomersOnly = types.BoolValue(data.PaymentNewCustomersOnly)
+	ret.PaymentRenewGracePeriod = types.Int32Value(data.PaymentRenewGracePeriod)
+	ret.PaymentTrialNewCustomersOnly = types.BoolValue(data.PaymentTrialNewCustomersOnly)
+	if data.Schedule != nil {
+		Schedule := ScheduleDataSourceModelFrom(*data.Schedule)
+		ret.Schedule = &Schedule
+	}
+	ret.ScheduleBilling = types.StringPointerValue(data.ScheduleBilling)
+	return &ret
+}
+
+// TermExternalDataSourceModelFrom extracts the attributes of external terms.
+func TermExternalDataSourceModelFrom(data piano_publisher.ExternalTerm) *TermExternalDataSourceModel {
+	ret := TermExternalDataSourceModel{}
+	ret.EvtFixedTimeAccessPeriod = types.Int32PointerValue(data.EvtFixedTimeAccessPeriod)
+	ret.EvtGooglePlayProductId = types.StringPointerValue(data.EvtGooglePlayProductId)
+	ret.EvtGracePeriod = types.Int32Value(data.EvtGracePeriod)
+	ret.EvtItunesBundleId = types.StringValue(data.EvtItunesBundleId)
+	ret.EvtItunesProductId = types.StringValue(data.EvtItunesProductId)
+	ret.EvtVerificationPeriod = types.Int32PointerValue(data.EvtVerificationPeriod)
+	externalApiFormFieldsElements := []ExternalAPIFieldDataSourceModel{}
+	for _, element := range sortedExternalAPIFields(data.ExternalApiFormFields) {
+		externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldDataSourceModelFrom(element))
+	}
+	ret.ExternalApiFormFields = externalApiFormFieldsElements
+	ret.ExternalApiId = types.StringValue(data.ExternalApiId)
+	ret.ExternalApiName = types.StringValue(data.ExternalApiName)
+	ret.ExternalApiSource = types.Int32Value(int32(data.ExternalApiSource))
+	ret.SharedAccountCount = types.Int32PointerValue(data.SharedAccountCount)
+	ret.SharedRedemptionUrl = types.StringPointerValue(data.SharedRedemptionUrl)
+	return &ret
+}
+
+// isGiftTerm reports whether the term type is a gift term.
+func isGiftTerm(termType piano_publisher.TermType) bool {
+	return termType == piano_publisher.TermTypeGift
+}
+
+// isContractTerm reports whether the term type is one of the contract term types.
+func isContractTerm(termType piano_publisher.TermType) bool {
+	switch termType {
+	case piano_publisher.TermTypeSpecificEmailAddressesContract,
+		piano_publisher.TermTypeEmailDomainContract,
+		piano_publisher.TermTypeIpRangeContract:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSubscriptionTerm reports whether the term is a payment or dynamic term billed as a subscription.
+// payment_is_subscription is meaningless for other term types.
+func isSubscriptionTerm(termType piano_publisher.TermType, paymentIsSubscription bool) bool {
+	switch termType {
+	case piano_publisher.TermTypePayment, piano_publisher.TermTypeDynamic:
+		return paymentIsSubscription
+	default:
+		return false
+	}
+}
+
+// nextSellDateFrom returns the earliest sell date after now among the non-deleted periods of schedule.
+// It returns null when schedule is nil or no period goes on sale after now.
+func nextSellDateFrom(schedule *piano_publisher.Schedule, now time.Time) types.Int64 {
+	if schedule == nil {
+		return types.Int64Null()
+	}
+	next := types.Int64Null()
+	for _, period := range schedule.Periods {
+		sellDate := int64(period.SellDate)
+		if period.Deleted || sellDate <= now.Unix() {
+			continue
+		}
+		if next.IsNull() || sellDate < next.ValueInt64() {
+			next = types.Int64Value(sellDate)
+		}
+	}
+	return next
+}
+
+// termOffersFrom lists the offers of the application and returns the ones which contain the term.
+// piano.io API does not return offers with a term, so it fetches every page of the offer list.
+func termOffersFrom(ctx context.Context, client piano_publisher.ClientInterface, aid string, termId string, diagnostics *diag.Diagnostics) ([]LightOfferDataSourceModel, bool) {
+	orderBy := piano_publisher.GetPublisherOfferListParamsOrderByOfferId
+	orderDirection := piano_publisher.GetPublisherOfferListParamsOrderDirectionAsc
+	data, err := syntax.Paginate(ctx, func(offset, limit int) ([]piano_publisher.OfferModel, int, error) {
+		params := piano_publisher.GetPublisherOfferListParams{
+			Aid:            aid,
+			Offset:         int32(offset),
+			Limit:          int32(limit),
+			OrderBy:        &orderBy,
+			OrderDirection: &orderDirection,
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching offers in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := client.GetPublisherOfferList(ctx, &params)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch offers, got error: %s", err))
+			return nil, 0, err
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, diagnostics)
+		if err != nil {
+			return nil, 0, err
+		}
+		result := piano_publisher.OfferModelArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return nil, 0, err
+		}
+		return result.Offers, syntax.TotalFrom(anyResponse), nil
+	})
+	if err != nil {
+		return nil, false
+	}
+	offers := []LightOfferDataSourceModel{}
+	for _, offer := range data {
+		if slices.ContainsFunc(offer.Terms, func(term piano_publisher.Term) bool { return term.TermId == termId }) {
+			offers = append(offers, LightOfferDataSourceModelFrom(piano_publisher.LightOffer{Name: offer.Name, OfferId: offer.OfferId}))
+		}
+	}
+	return offers, true
+}
+
+// termChangeOptionComputedAttributes returns the attributes of a term change option in data sources.
+func termChangeOptionComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"from_resource_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the \"From\" resource",
+		},
+		"from_period_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the \"From\" term period",
+		},
+		"to_period_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The period name of the \"To\" term",
+		},
+		"prorate_access": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the <a href=\"https://docs.piano.io/upgrades/?paragraphId=b27954ef84407e4#prorate-billing-amount\">Prorate billing amount</a> function is enabled",
+		},
+		"to_billing_plan": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The \"To\" billing plan",
+		},
+		"from_period_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the \"From\" term period",
+		},
+		"from_term_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the \"From\" term",
+		},
+		"advanced_options": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"show_options": schema.SetAttribute{
+					Computed:    true,
+					ElementType: basetypes.StringType{},
+				},
+			},
+		},
+		"term_change_option_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the term change option",
+		},
+		"to_term_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the \"To\" term",
+		},
+		"to_term_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the \"To\" term",
+		},
+		"include_trial": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether trial is enabled (not in use, always \"FALSE\")",
+		},
+		"immediate_access": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the access begins immediately",
+		},
+		"from_term_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the \"From\" term",
+		},
+		"from_billing_plan": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The \"From\" billing plan",
+		},
+		"upgrade_offers": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"offer_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The offer ID",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The offer name",
+					},
+				},
+			},
+		},
+		"shared_account_count": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The count of allowed shared-subscription accounts",
+		},
+		"from_resource_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the \"From\" resource",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "A description of the term change option; provided by the client",
+		},
+		"from_scheduled": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the subscription is upgraded from a scheduled term",
+		},
+		"billing_timing": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The billing timing(0: immediate term change;1: term change at the end of the current cycle;2: term change on the next sell date;3: term change at the end of the current period)",
+			Validators: []validator.String{
+				stringvalidator.OneOf("0", "1", "2", "3"),
+			},
+		},
+		"collect_address": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether to collect an address for this term",
+		},
+		"to_resource_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the \"To\" resource",
+		},
+		"to_period_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the \"To\" term period",
+		},
+		"to_resource_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the \"To\" resource",
+		},
+		"to_scheduled": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the subscription is upgraded to a scheduled term",
+		},
+	}
+}
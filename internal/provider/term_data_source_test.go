@@ -0,0 +1,262 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTermTypeHelpers(t *testing.T) {
+	cases := []struct {
+		termType              piano_publisher.TermType
+		paymentIsSubscription bool
+		isGift                bool
+		isContract            bool
+		isSubscription        bool
+	}{
+		{termType: piano_publisher.TermTypePayment, paymentIsSubscription: true, isSubscription: true},
+		{termType: piano_publisher.TermTypePayment, paymentIsSubscription: false},
+		{termType: piano_publisher.TermTypeDynamic, paymentIsSubscription: true, isSubscription: true},
+		{termType: piano_publisher.TermTypeGift, isGift: true},
+		{termType: piano_publisher.TermTypeGift, paymentIsSubscription: true, isGift: true},
+		{termType: piano_publisher.TermTypeSpecificEmailAddressesContract, isContract: true},
+		{termType: piano_publisher.TermTypeEmailDomainContract, isContract: true},
+		{termType: piano_publisher.TermTypeIpRangeContract, isContract: true},
+		{termType: piano_publisher.TermTypeRegistration},
+		{termType: piano_publisher.TermTypeExternal, paymentIsSubscription: true},
+	}
+	for _, c := range cases {
+		if actual := isGiftTerm(c.termType); actual != c.isGift {
+			t.Errorf("isGiftTerm(%s): expected %t, got %t", c.termType, c.isGift, actual)
+		}
+		if actual := isContractTerm(c.termType); actual != c.isContract {
+			t.Errorf("isContractTerm(%s): expected %t, got %t", c.termType, c.isContract, actual)
+		}
+		if actual := isSubscriptionTerm(c.termType, c.paymentIsSubscription); actual != c.isSubscription {
+			t.Errorf("isSubscriptionTerm(%s, %t): expected %t, got %t", c.termType, c.paymentIsSubscription, c.isSubscription, actual)
+		}
+	}
+}
+
+func TestNextSellDateFrom(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	schedule := &piano_publisher.Schedule{
+		Periods: []piano_publisher.Period{
+			{PeriodId: "past", SellDate: 1690000000},
+			{PeriodId: "later", SellDate: 1720000000},
+			{PeriodId: "deleted", SellDate: 1705000000, Deleted: true},
+			{PeriodId: "next", SellDate: 1710000000},
+			{PeriodId: "now", SellDate: 1700000000},
+		},
+	}
+	if actual := nextSellDateFrom(schedule, now); !actual.Equal(types.Int64Value(1710000000)) {
+		t.Errorf("expected the nearest future sell date 1710000000, got %s", actual)
+	}
+	pastOnly := &piano_publisher.Schedule{
+		Periods: []piano_publisher.Period{
+			{PeriodId: "past", SellDate: 1690000000},
+		},
+	}
+	if actual := nextSellDateFrom(pastOnly, now); !actual.IsNull() {
+		t.Errorf("expected null when no period goes on sale in the future, got %s", actual)
+	}
+	if actual := nextSellDateFrom(nil, now); !actual.IsNull() {
+		t.Errorf("expected null without schedule, got %s", actual)
+	}
+}
+
+func TestTermDataSourceReadSetsBlockOfTermType(t *testing.T) {
+	cases := []struct {
+		name         string
+		term         string
+		payment      bool
+		external     bool
+		registration bool
+	}{
+		{
+			name:    "payment term",
+			term:    `{"aid":"example","term_id":"TMPAY","type":"payment","payment_currency":"JPY","schedule_billing":"billing"}`,
+			payment: true,
+		},
+		{
+			name:     "external term",
+			term:     `{"aid":"example","term_id":"TMEXT","type":"external","external_api_id":"API1","evt_grace_period":3,"external_api_form_fields":[{"field_name":"email","field_title":"Email","editable":"true","mandatory":true,"hidden":false,"order":1}]}`,
+			external: true,
+		},
+		{
+			name:         "registration term",
+			term:         `{"aid":"example","term_id":"TMREG","type":"registration","registration_access_period":3600}`,
+			registration: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if req.URL.Path == "/publisher/offer/list" {
+					fmt.Fprint(w, `{"code":0,"offers":[]}`)
+					return
+				}
+				fmt.Fprintf(w, `{"code":0,"term":%s}`, c.term)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &TermDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, nil)
+			diags := state.SetAttribute(ctx, path.Root("term_id"), types.StringValue("TM"))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual TermDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if (actual.Payment != nil) != c.payment || (actual.External != nil) != c.external || (actual.Registration != nil) != c.registration {
+				t.Fatalf("unexpected blocks: payment %v, external %v, registration %v", actual.Payment, actual.External, actual.Registration)
+			}
+			if c.payment && (actual.Payment.PaymentCurrency.ValueString() != "JPY" || actual.Payment.ScheduleBilling.ValueString() != "billing") {
+				t.Errorf("unexpected payment block: %v", actual.Payment)
+			}
+			if c.external && (actual.External.ExternalApiId.ValueString() != "API1" || actual.External.EvtGracePeriod.ValueInt32() != 3 || len(actual.External.ExternalApiFormFields) != 1) {
+				t.Errorf("unexpected external block: %v", actual.External)
+			}
+			if c.registration && actual.Registration.RegistrationAccessPeriod.ValueInt32() != 3600 {
+				t.Errorf("unexpected registration block: %v", actual.Registration)
+			}
+		})
+	}
+}
+
+func TestTermDataSourceReadSetsOffers(t *testing.T) {
+	ctx := context.Background()
+	offsets := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path != "/publisher/offer/list" {
+			fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXX1","type":"payment"}}`)
+			return
+		}
+		offset := req.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		if offset != "0" {
+			fmt.Fprint(w, `{"code":0,"offers":[{"offer_id":"OF3","name":"third","terms":[{"term_id":"TMXXXXX1"}]}]}`)
+			return
+		}
+		offers := []string{
+			`{"offer_id":"OF1","name":"first","terms":[{"term_id":"TMXXXXX1"},{"term_id":"TMXXXXX2"}]}`,
+			`{"offer_id":"OF2","name":"second","terms":[{"term_id":"TMXXXXX2"}]}`,
+		}
+		for len(offers) < syntax.PageSize {
+			offers = append(offers, `{"offer_id":"OFX","name":"other","terms":[]}`)
+		}
+		fmt.Fprintf(w, `{"code":0,"offers":[%s]}`, strings.Join(offers, ","))
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &TermDataSource{client: client}
+
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	state := newTestState(t, schemaResp.Schema, nil)
+	diags := state.SetAttribute(ctx, path.Root("term_id"), types.StringValue("TMXXXXX1"))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+	resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual TermDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	ids := []string{}
+	for _, offer := range actual.Offers {
+		ids = append(ids, offer.OfferId.ValueString())
+	}
+	if !reflect.DeepEqual(ids, []string{"OF1", "OF3"}) {
+		t.Errorf("expected offers containing the term, got %v", ids)
+	}
+	if !reflect.DeepEqual(offsets, []string{"0", fmt.Sprint(syntax.PageSize)}) {
+		t.Errorf("expected offers to be paginated, got offsets %v", offsets)
+	}
+}
+
+func TestSortedTermChangeOptions_Stable(t *testing.T) {
+	first := piano_publisher.TermChangeOption{TermChangeOptionId: "TCO1", ToTermId: "TMXXXXX3"}
+	second := piano_publisher.TermChangeOption{TermChangeOptionId: "TCO2", ToTermId: "TMXXXXX1"}
+	third := piano_publisher.TermChangeOption{TermChangeOptionId: "TCO3", ToTermId: "TMXXXXX2"}
+
+	expected := sortedTermChangeOptions([]piano_publisher.TermChangeOption{first, second, third})
+	actual := sortedTermChangeOptions([]piano_publisher.TermChangeOption{third, first, second})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected reordered change_options to produce no diff, got %v and %v", expected, actual)
+	}
+	ids := []string{}
+	for _, element := range actual {
+		ids = append(ids, element.TermChangeOptionId)
+	}
+	if !reflect.DeepEqual(ids, []string{"TCO1", "TCO2", "TCO3"}) {
+		t.Errorf("expected change_options to be sorted by term_change_option_id, got %v", ids)
+	}
+}
+
+func TestSortedExternalAPIFields_Stable(t *testing.T) {
+	email := piano_publisher.ExternalAPIField{FieldName: "email", Order: 1}
+	name := piano_publisher.ExternalAPIField{FieldName: "name", Order: 1}
+	country := piano_publisher.ExternalAPIField{FieldName: "country", Order: 2}
+
+	input := []piano_publisher.ExternalAPIField{country, name, email}
+	expected := sortedExternalAPIFields([]piano_publisher.ExternalAPIField{email, name, country})
+	actual := sortedExternalAPIFields(input)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected reordered external_api_form_fields to produce no diff, got %v and %v", expected, actual)
+	}
+	names := []string{}
+	for _, element := range actual {
+		names = append(names, element.FieldName)
+	}
+	if !reflect.DeepEqual(names, []string{"email", "name", "country"}) {
+		t.Errorf("expected external_api_form_fields to be sorted by order and field_name, got %v", names)
+	}
+	if input[0].FieldName != "country" {
+		t.Errorf("expected the input not to be modified, got %v", input)
+	}
+}
@@ -127,6 +127,7 @@ func (*ExternalTermDataSource) Schema(ctx context.Context, req datasource.Schema
 			"aid": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The application ID",
+				Validators:          aidValidators(),
 			},
 			"external_api_source": schema.Int32Attribute{
 				Computed:            true,
@@ -286,22 +287,13 @@ func NewExternalTermDataSource() datasource.DataSource {
 
 // TermDataSource defines the data source implementation.
 type ExternalTermDataSource struct {
-	client *piano_publisher.Client
+	client piano_publisher.ClientInterface
 }
 
 func (r *ExternalTermDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 	r.client = &client.publisherClient
@@ -353,7 +345,7 @@ func (r *ExternalTermDataSource) Read(ctx context.Context, req datasource.ReadRe
 	state.ExternalApiSource = types.Int32Value(int32(data.ExternalApiSource))
 	state.Aid = types.StringValue(data.Aid)
 	externalApiFormFieldsElements := []ExternalAPIFieldDataSourceModel{}
-	for _, element := range data.ExternalApiFormFields {
+	for _, element := range sortedExternalAPIFields(data.ExternalApiFormFields) {
 		externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldDataSourceModelFrom(element))
 	}
 	state.ExternalApiFormFields = externalApiFormFieldsElements
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -57,9 +58,12 @@ type ExternalTermResourceModel struct {
 	ExternalApiSource     types.Int32                            `tfsdk:"external_api_source"` // The source of the external API configuration
 	CreateDate            types.Int64                            `tfsdk:"create_date"`         // The creation date
 	UpdateDate            types.Int64                            `tfsdk:"update_date"`         // The update date
+	CreateDateIso         types.String                           `tfsdk:"create_date_iso"`     // The creation date in RFC3339 format
+	UpdateDateIso         types.String                           `tfsdk:"update_date_iso"`     // The update date in RFC3339 format
 	Type                  types.String                           `tfsdk:"type"`                // The term type
 	Resource              *ResourceResourceModel                 `tfsdk:"resource"`
 	ExternalApiFormFields ExternalAPIFieldResourceModelListValue `tfsdk:"external_api_form_fields"`
+	Timeouts              types.Object                           `tfsdk:"timeouts"` // The timeouts of the operations
 }
 
 func (r *ExternalTermResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,10 +73,7 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "ExternalTerm resource. External term is a term that is created by the external API.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"term_id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -81,8 +82,10 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "The term ID",
 			},
 			"external_api_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the external API configuration",
+				Required: true,
+				// piano.io publisher API does not provide endpoints to manage external API configurations.
+				MarkdownDescription: "The ID of the external API configuration. " +
+					"External API configurations are not available in piano.io publisher API and must be created in piano.io dashboard.",
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
@@ -186,7 +189,8 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
-						MarkdownDescription: "The resource ID",
+						// piano.io API moves an external term to another resource in place.
+						MarkdownDescription: "The resource ID. Changing this value moves the term to the resource without recreating the term.",
 					},
 					"aid": schema.StringAttribute{
 						Computed: true,
@@ -247,6 +251,26 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 							stringvalidator.OneOf("standard", "bundle", "print"),
 						},
 					},
+					"type_label": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "The resource type label ('Standard', 'Bundle' or 'Print')",
+						Validators: []validator.String{
+							stringvalidator.OneOf("Standard", "Bundle", "Print"),
+						},
+					},
+					"bundle_type_label": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "The bundle type label ('Fixed', 'Fixed 2.0', 'Tagged' or 'Undefined'). This value is null for non-bundle resources.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("Fixed", "Fixed 2.0", "Tagged", "Undefined"),
+						},
+					},
 					"deleted": schema.BoolAttribute{
 						Computed: true,
 						PlanModifiers: []planmodifier.Bool{
@@ -284,6 +308,13 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 						},
 						MarkdownDescription: "The external ID; defined by the client",
 					},
+					"published": schema.BoolAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "Whether the resource is published",
+					},
 					"publish_date": schema.Int64Attribute{
 						Computed: true,
 						PlanModifiers: []planmodifier.Int64{
@@ -322,6 +353,8 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 				MarkdownDescription: "The name of the external API configuration",
 			},
+			"create_date_iso": unixTimeIsoAttribute("The creation date"),
+			"update_date_iso": unixTimeIsoAttribute("The update date"),
 			"update_date": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The update date",
@@ -343,6 +376,7 @@ func (*ExternalTermResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringvalidator.OneOf("payment", "adview", "registration", "newsletter", "external", "custom", "grant_access", "gift", "specific_email_addresses_contract", "email_domain_contract", "ip_range_contract", "dynamic", "linked"),
 				},
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}
 }
@@ -350,27 +384,34 @@ func NewExternalTermResource() resource.Resource {
 	return &ExternalTermResource{}
 }
 
+var _ resource.ResourceWithModifyPlan = &ExternalTermResource{}
+
 // ExternalTermResource defines the data source implementation.
 type ExternalTermResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
 }
 
 func (r *ExternalTermResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
+}
 
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+func (r *ExternalTermResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	r.client = &client.publisherClient
+	planTermType(ctx, piano_publisher.TermTypeExternal, req, resp)
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "create_date", "update_date")
 }
 
 func (r *ExternalTermResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -382,6 +423,8 @@ func (r *ExternalTermResource) Create(ctx context.Context, req resource.CreateRe
 		tflog.Error(ctx, fmt.Sprintf("%v", resp.Diagnostics))
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutCreate)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("creating resource %s in %s", state.Name.ValueString(), state.Aid.ValueString()))
 
@@ -413,36 +456,33 @@ func (r *ExternalTermResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, &resp.Diagnostics) {
+		return
+	}
 	data := result.Term
 	state.ExternalApiId = types.StringValue(data.ExternalApiId)
 	state.Type = types.StringValue(string(data.Type))
-	state.SharedRedemptionUrl = types.StringPointerValue(data.SharedRedemptionUrl)
-	state.EvtGracePeriod = types.Int32Value(data.EvtGracePeriod)
-	state.EvtFixedTimeAccessPeriod = types.Int32PointerValue(data.EvtFixedTimeAccessPeriod)
+	state.SharedRedemptionUrl = syntax.ReconcileOptionalString(state.SharedRedemptionUrl, data.SharedRedemptionUrl)
+	state.EvtGracePeriod = syntax.ReconcileOptionalInt32(state.EvtGracePeriod, &data.EvtGracePeriod)
+	state.EvtFixedTimeAccessPeriod = syntax.ReconcileOptionalInt32(state.EvtFixedTimeAccessPeriod, data.EvtFixedTimeAccessPeriod)
 	Resource := ResourceResourceModelFrom(data.Resource)
 	state.Resource = &Resource
-	state.EvtGooglePlayProductId = types.StringPointerValue(data.EvtGooglePlayProductId)
-	state.EvtVerificationPeriod = types.Int32PointerValue(data.EvtVerificationPeriod)
+	state.EvtGooglePlayProductId = syntax.ReconcileOptionalString(state.EvtGooglePlayProductId, data.EvtGooglePlayProductId)
+	state.EvtVerificationPeriod = syntax.ReconcileOptionalInt32(state.EvtVerificationPeriod, data.EvtVerificationPeriod)
 	state.CreateDate = types.Int64Value(int64(data.CreateDate))
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
+	state.UpdateDateIso = unixTimeIsoFrom(state.UpdateDate, r.dateLocation)
 	state.ExternalApiName = types.StringValue(data.ExternalApiName)
 	state.ExternalApiSource = types.Int32Value(int32(data.ExternalApiSource))
 	state.Aid = types.StringValue(data.Aid)
-	state.SharedAccountCount = types.Int32PointerValue(data.SharedAccountCount)
-	if state.EvtItunesProductId.IsUnknown() && data.EvtItunesProductId == "" {
-		state.EvtItunesProductId = types.StringNull()
-	} else {
-		state.EvtItunesProductId = types.StringValue(data.EvtItunesProductId)
-	}
-	if state.EvtItunesBundleId.IsUnknown() && data.EvtItunesBundleId == "" {
-		state.EvtItunesBundleId = types.StringNull()
-	} else {
-		state.EvtItunesBundleId = types.StringValue(data.EvtItunesBundleId)
-	}
+	state.SharedAccountCount = syntax.ReconcileOptionalInt32(state.SharedAccountCount, data.SharedAccountCount)
+	state.EvtItunesProductId = syntax.ReconcileOptionalString(state.EvtItunesProductId, &data.EvtItunesProductId)
+	state.EvtItunesBundleId = syntax.ReconcileOptionalString(state.EvtItunesBundleId, &data.EvtItunesBundleId)
 	state.Name = types.StringValue(data.Name)
 
 	externalApiFormFieldsElements := []ExternalAPIFieldResourceModel{}
-	for _, element := range data.ExternalApiFormFields {
+	for _, element := range sortedExternalAPIFields(data.ExternalApiFormFields) {
 		externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldResourceModelFrom(element))
 	}
 	listValue, diags := basetypes.NewListValueFrom(ctx, ExternalAPIFieldAttrType(), externalApiFormFieldsElements)
@@ -451,37 +491,42 @@ func (r *ExternalTermResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	state.ExternalApiFormFields = ExternalAPIFieldResourceModelListValue{ListValue: listValue}
-	state.Description = types.StringValue(data.Description)
+	state.Description = syntax.ReconcileOptionalString(state.Description, &data.Description)
 	state.TermId = types.StringValue(data.TermId)
 	tflog.Info(ctx, fmt.Sprintf("complete creating resource %s(id: %s)", state.Name, state.TermId))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 func (r *ExternalTermResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state ExternalTermResourceModel
+	var prior ExternalTermResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("%v", resp.Diagnostics))
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutUpdate)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("updating resource %s in %s", state.Name.ValueString(), state.Aid.ValueString()))
+	// only changed attributes are sent so that fields changed outside terraform are kept as they are
 	request := piano_publisher.PostPublisherTermExternalUpdateFormdataRequestBody{
 		TermId:                   state.TermId.ValueString(),
 		ExternalApiId:            state.ExternalApiId.ValueString(),
 		Name:                     state.Name.ValueString(),
-		Description:              state.Description.ValueStringPointer(),
-		EvtFixedTimeAccessPeriod: state.EvtFixedTimeAccessPeriod.ValueInt32Pointer(),
-		EvtGracePeriod:           state.EvtGracePeriod.ValueInt32Pointer(),
-		EvtVerificationPeriod:    state.EvtVerificationPeriod.ValueInt32Pointer(),
-		EvtItunesBundleId:        state.EvtItunesBundleId.ValueStringPointer(),
-		EvtItunesProductId:       state.EvtItunesProductId.ValueStringPointer(),
-		EvtGooglePlayProductId:   state.EvtGooglePlayProductId.ValueStringPointer(),
-		SharedAccountCount:       state.SharedAccountCount.ValueInt32Pointer(),
-		SharedRedemptionUrl:      state.SharedRedemptionUrl.ValueStringPointer(),
-	}
-	if !state.Resource.Rid.IsNull() {
+		Description:              syntax.ChangedString(state.Description, prior.Description),
+		EvtFixedTimeAccessPeriod: syntax.ChangedInt32(state.EvtFixedTimeAccessPeriod, prior.EvtFixedTimeAccessPeriod),
+		EvtGracePeriod:           syntax.ChangedInt32(state.EvtGracePeriod, prior.EvtGracePeriod),
+		EvtVerificationPeriod:    syntax.ChangedInt32(state.EvtVerificationPeriod, prior.EvtVerificationPeriod),
+		EvtItunesBundleId:        syntax.ChangedString(state.EvtItunesBundleId, prior.EvtItunesBundleId),
+		EvtItunesProductId:       syntax.ChangedString(state.EvtItunesProductId, prior.EvtItunesProductId),
+		EvtGooglePlayProductId:   syntax.ChangedString(state.EvtGooglePlayProductId, prior.EvtGooglePlayProductId),
+		SharedAccountCount:       syntax.ChangedInt32(state.SharedAccountCount, prior.SharedAccountCount),
+		SharedRedemptionUrl:      syntax.ChangedString(state.SharedRedemptionUrl, prior.SharedRedemptionUrl),
+	}
+	if prior.Resource == nil || !state.Resource.Rid.Equal(prior.Resource.Rid) {
 		request.Rid = state.Resource.Rid.ValueStringPointer()
 	}
 	response, err := r.client.PostPublisherTermExternalUpdateWithFormdataBody(ctx, request)
@@ -504,21 +549,23 @@ func (r *ExternalTermResource) Update(ctx context.Context, req resource.UpdateRe
 	data := result.Term
 	state.ExternalApiId = types.StringValue(data.ExternalApiId)
 	state.Type = types.StringValue(string(data.Type))
-	state.SharedRedemptionUrl = types.StringPointerValue(data.SharedRedemptionUrl)
-	state.EvtGracePeriod = types.Int32Value(data.EvtGracePeriod)
-	state.EvtFixedTimeAccessPeriod = types.Int32PointerValue(data.EvtFixedTimeAccessPeriod)
+	state.SharedRedemptionUrl = syntax.ReconcileOptionalString(state.SharedRedemptionUrl, data.SharedRedemptionUrl)
+	state.EvtGracePeriod = syntax.ReconcileOptionalInt32(state.EvtGracePeriod, &data.EvtGracePeriod)
+	state.EvtFixedTimeAccessPeriod = syntax.ReconcileOptionalInt32(state.EvtFixedTimeAccessPeriod, data.EvtFixedTimeAccessPeriod)
 	Resource := ResourceResourceModelFrom(data.Resource)
 	state.Resource = &Resource
-	state.EvtGooglePlayProductId = types.StringPointerValue(data.EvtGooglePlayProductId)
-	state.EvtVerificationPeriod = types.Int32PointerValue(data.EvtVerificationPeriod)
+	state.EvtGooglePlayProductId = syntax.ReconcileOptionalString(state.EvtGooglePlayProductId, data.EvtGooglePlayProductId)
+	state.EvtVerificationPeriod = syntax.ReconcileOptionalInt32(state.EvtVerificationPeriod, data.EvtVerificationPeriod)
 	state.CreateDate = types.Int64Value(int64(data.CreateDate))
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
+	state.UpdateDateIso = unixTimeIsoFrom(state.UpdateDate, r.dateLocation)
 	state.ExternalApiName = types.StringValue(data.ExternalApiName)
 	state.ExternalApiSource = types.Int32Value(int32(data.ExternalApiSource))
 	state.Aid = types.StringValue(data.Aid)
 
 	externalApiFormFieldsElements := []ExternalAPIFieldResourceModel{}
-	for _, element := range data.ExternalApiFormFields {
+	for _, element := range sortedExternalAPIFields(data.ExternalApiFormFields) {
 		externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldResourceModelFrom(element))
 	}
 	listValue, diags := basetypes.NewListValueFrom(ctx, ExternalAPIFieldAttrType(), externalApiFormFieldsElements)
@@ -527,19 +574,11 @@ func (r *ExternalTermResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 	state.ExternalApiFormFields = ExternalAPIFieldResourceModelListValue{ListValue: listValue}
-	state.SharedAccountCount = types.Int32PointerValue(data.SharedAccountCount)
-	if state.EvtItunesProductId.IsUnknown() && data.EvtItunesProductId == "" {
-		state.EvtItunesProductId = types.StringNull()
-	} else {
-		state.EvtItunesProductId = types.StringValue(data.EvtItunesProductId)
-	}
-	if state.EvtItunesBundleId.IsUnknown() && data.EvtItunesBundleId == "" {
-		state.EvtItunesBundleId = types.StringNull()
-	} else {
-		state.EvtItunesBundleId = types.StringValue(data.EvtItunesBundleId)
-	}
+	state.SharedAccountCount = syntax.ReconcileOptionalInt32(state.SharedAccountCount, data.SharedAccountCount)
+	state.EvtItunesProductId = syntax.ReconcileOptionalString(state.EvtItunesProductId, &data.EvtItunesProductId)
+	state.EvtItunesBundleId = syntax.ReconcileOptionalString(state.EvtItunesBundleId, &data.EvtItunesBundleId)
 	state.Name = types.StringValue(data.Name)
-	state.Description = types.StringValue(data.Description)
+	state.Description = syntax.ReconcileOptionalString(state.Description, &data.Description)
 	tflog.Info(ctx, fmt.Sprintf("complete updating resource %s(id: %s)", state.Name, state.TermId))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -554,6 +593,8 @@ func (r *ExternalTermResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutRead)
+	defer cancel()
 
 	response, err := r.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
 		TermId: state.TermId.ValueString(),
@@ -573,25 +614,33 @@ func (r *ExternalTermResource) Read(ctx context.Context, req resource.ReadReques
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, &resp.Diagnostics) {
+		return
+	}
 
 	data := result.Term
 	state.ExternalApiId = types.StringValue(data.ExternalApiId)
 	state.Type = types.StringValue(string(data.Type))
-	state.SharedRedemptionUrl = types.StringPointerValue(data.SharedRedemptionUrl)
-	state.EvtGracePeriod = types.Int32Value(data.EvtGracePeriod)
-	state.EvtFixedTimeAccessPeriod = types.Int32PointerValue(data.EvtFixedTimeAccessPeriod)
+	state.SharedRedemptionUrl = syntax.ReconcileOptionalString(state.SharedRedemptionUrl, data.SharedRedemptionUrl)
+	state.EvtGracePeriod = syntax.ReconcileOptionalInt32(state.EvtGracePeriod, &data.EvtGracePeriod)
+	state.EvtFixedTimeAccessPeriod = syntax.ReconcileOptionalInt32(state.EvtFixedTimeAccessPeriod, data.EvtFixedTimeAccessPeriod)
 	Resource := ResourceResourceModelFrom(data.Resource)
 	state.Resource = &Resource
-	state.EvtGooglePlayProductId = types.StringPointerValue(data.EvtGooglePlayProductId)
-	state.EvtVerificationPeriod = types.Int32PointerValue(data.EvtVerificationPeriod)
+	state.EvtGooglePlayProductId = syntax.ReconcileOptionalString(state.EvtGooglePlayProductId, data.EvtGooglePlayProductId)
+	state.EvtVerificationPeriod = syntax.ReconcileOptionalInt32(state.EvtVerificationPeriod, data.EvtVerificationPeriod)
 	state.CreateDate = types.Int64Value(int64(data.CreateDate))
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
+	state.UpdateDateIso = unixTimeIsoFrom(state.UpdateDate, r.dateLocation)
 	state.ExternalApiName = types.StringValue(data.ExternalApiName)
 	state.ExternalApiSource = types.Int32Value(int32(data.ExternalApiSource))
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
+	}
 	state.Aid = types.StringValue(data.Aid)
 
 	externalApiFormFieldsElements := []ExternalAPIFieldResourceModel{}
-	for _, element := range data.ExternalApiFormFields {
+	for _, element := range sortedExternalAPIFields(data.ExternalApiFormFields) {
 		externalApiFormFieldsElements = append(externalApiFormFieldsElements, ExternalAPIFieldResourceModelFrom(element))
 	}
 	listValue, diags := basetypes.NewListValueFrom(ctx, ExternalAPIFieldAttrType(), externalApiFormFieldsElements)
@@ -600,19 +649,11 @@ func (r *ExternalTermResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 	state.ExternalApiFormFields = ExternalAPIFieldResourceModelListValue{ListValue: listValue}
-	state.SharedAccountCount = types.Int32PointerValue(data.SharedAccountCount)
-	if state.EvtItunesProductId.IsNull() && data.EvtItunesProductId == "" {
-		state.EvtItunesProductId = types.StringNull()
-	} else {
-		state.EvtItunesProductId = types.StringValue(data.EvtItunesProductId)
-	}
-	if state.EvtItunesBundleId.IsNull() && data.EvtItunesBundleId == "" {
-		state.EvtItunesBundleId = types.StringNull()
-	} else {
-		state.EvtItunesBundleId = types.StringValue(data.EvtItunesBundleId)
-	}
+	state.SharedAccountCount = syntax.ReconcileOptionalInt32(state.SharedAccountCount, data.SharedAccountCount)
+	state.EvtItunesProductId = syntax.ReconcileOptionalString(state.EvtItunesProductId, &data.EvtItunesProductId)
+	state.EvtItunesBundleId = syntax.ReconcileOptionalString(state.EvtItunesBundleId, &data.EvtItunesBundleId)
 	state.Name = types.StringValue(data.Name)
-	state.Description = types.StringValue(data.Description)
+	state.Description = syntax.ReconcileOptionalString(state.Description, &data.Description)
 	tflog.Trace(ctx, "read a resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -624,6 +665,8 @@ func (r *ExternalTermResource) Delete(ctx context.Context, req resource.DeleteRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutDelete)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("deleting Term %s:%s in $%s", state.Name.ValueString(), state.TermId.ValueString(), state.Aid.ValueString()))
 	response, err := r.client.PostPublisherTermDeleteWithFormdataBody(ctx, piano_publisher.PostPublisherTermDeleteFormdataRequestBody{
@@ -633,7 +676,7 @@ func (r *ExternalTermResource) Delete(ctx context.Context, req resource.DeleteRe
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
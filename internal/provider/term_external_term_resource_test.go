@@ -0,0 +1,199 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExternalTermResourceUpdateRid(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/publisher/term/external/update" {
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if rid := req.PostForm.Get("rid"); rid != "RNEW" {
+			t.Errorf("expected rid to be RNEW, got %q", rid)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"App Store","description":"","type":"external","external_api_id":"EXT","external_api_name":"App Store","external_api_source":1,`+
+			`"resource":{"aid":"example","rid":"RNEW","name":"Premium","type":"standard","create_date":1735657200,"update_date":1735657200},"external_api_form_fields":[],"create_date":1735657200,"update_date":1735657300}}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ExternalTermResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	prior := ExternalTermResourceModel{
+		Aid:                   types.StringValue("example"),
+		TermId:                types.StringValue("TMXXXXXX"),
+		ExternalApiId:         types.StringValue("EXT"),
+		Name:                  types.StringValue("App Store"),
+		Type:                  types.StringValue("external"),
+		Resource:              &ResourceResourceModel{Aid: types.StringValue("example"), Rid: types.StringValue("ROLD")},
+		ExternalApiFormFields: ExternalAPIFieldResourceModelListValue{ListValue: types.ListNull(ExternalAPIFieldAttrType())},
+		Timeouts:              timeoutsNull(),
+	}
+	state := newTestState(t, schemaResp.Schema, &prior)
+	planned := prior
+	planned.Resource = &ResourceResourceModel{Aid: types.StringUnknown(), Rid: types.StringValue("RNEW")}
+	planned.UpdateDate = types.Int64Unknown()
+	plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual ExternalTermResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if actual.TermId.ValueString() != "TMXXXXXX" {
+		t.Errorf("expected the term to be updated in place, got %s", actual.TermId)
+	}
+	if actual.Resource == nil || actual.Resource.Rid.ValueString() != "RNEW" {
+		t.Errorf("expected rid to be RNEW, got %v", actual.Resource)
+	}
+}
+
+func TestExternalTermResourceUpdateOnlyChangedFields(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if description := req.PostForm.Get("description"); description != "Updated" {
+			t.Errorf("expected description to be Updated, got %q", description)
+		}
+		for _, name := range []string{"evt_grace_period", "shared_redemption_url", "rid"} {
+			if req.PostForm.Has(name) {
+				t.Errorf("expected unchanged %s to be omitted, got %q", name, req.PostForm.Get(name))
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"App Store","description":"Updated","type":"external","external_api_id":"EXT","external_api_name":"App Store","external_api_source":1,"evt_grace_period":3,`+
+			`"shared_redemption_url":"https://example.com/redeem","resource":{"aid":"example","rid":"RXXXXXX","name":"Premium","type":"standard","create_date":1735657200,"update_date":1735657200},"external_api_form_fields":[],"create_date":1735657200,"update_date":1735657300}}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ExternalTermResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	prior := ExternalTermResourceModel{
+		Aid:                   types.StringValue("example"),
+		TermId:                types.StringValue("TMXXXXXX"),
+		ExternalApiId:         types.StringValue("EXT"),
+		Name:                  types.StringValue("App Store"),
+		Description:           types.StringValue("Original"),
+		Type:                  types.StringValue("external"),
+		EvtGracePeriod:        types.Int32Value(3),
+		SharedRedemptionUrl:   types.StringValue("https://example.com/redeem"),
+		Resource:              &ResourceResourceModel{Aid: types.StringValue("example"), Rid: types.StringValue("RXXXXXX")},
+		ExternalApiFormFields: ExternalAPIFieldResourceModelListValue{ListValue: types.ListNull(ExternalAPIFieldAttrType())},
+		Timeouts:              timeoutsNull(),
+	}
+	state := newTestState(t, schemaResp.Schema, &prior)
+	planned := prior
+	planned.Description = types.StringValue("Updated")
+	planned.UpdateDate = types.Int64Unknown()
+	plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual ExternalTermResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if actual.Description.ValueString() != "Updated" || actual.EvtGracePeriod.ValueInt32() != 3 {
+		t.Errorf("unexpected state: %v", actual)
+	}
+}
+
+func TestExternalTermResourceUpdateRemovedFields(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]string{"evt_grace_period": "0", "shared_redemption_url": ""}
+		for name, value := range expected {
+			if !req.PostForm.Has(name) || req.PostForm.Get(name) != value {
+				t.Errorf("expected removed %s to be cleared with %q, got %v", name, value, req.PostForm[name])
+			}
+		}
+		if req.PostForm.Has("description") {
+			t.Errorf("expected unchanged description to be omitted, got %q", req.PostForm.Get("description"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"App Store","description":"Original","type":"external","external_api_id":"EXT","external_api_name":"App Store","external_api_source":1,"evt_grace_period":0,`+
+			`"shared_redemption_url":"","resource":{"aid":"example","rid":"RXXXXXX","name":"Premium","type":"standard","create_date":1735657200,"update_date":1735657200},"external_api_form_fields":[],"create_date":1735657200,"update_date":1735657300}}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ExternalTermResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	prior := ExternalTermResourceModel{
+		Aid:                   types.StringValue("example"),
+		TermId:                types.StringValue("TMXXXXXX"),
+		ExternalApiId:         types.StringValue("EXT"),
+		Name:                  types.StringValue("App Store"),
+		Description:           types.StringValue("Original"),
+		Type:                  types.StringValue("external"),
+		EvtGracePeriod:        types.Int32Value(3),
+		SharedRedemptionUrl:   types.StringValue("https://example.com/redeem"),
+		Resource:              &ResourceResourceModel{Aid: types.StringValue("example"), Rid: types.StringValue("RXXXXXX")},
+		ExternalApiFormFields: ExternalAPIFieldResourceModelListValue{ListValue: types.ListNull(ExternalAPIFieldAttrType())},
+		Timeouts:              timeoutsNull(),
+	}
+	state := newTestState(t, schemaResp.Schema, &prior)
+	planned := prior
+	planned.EvtGracePeriod = types.Int32Null()
+	planned.SharedRedemptionUrl = types.StringNull()
+	planned.UpdateDate = types.Int64Unknown()
+	plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual ExternalTermResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !actual.EvtGracePeriod.IsNull() || !actual.SharedRedemptionUrl.IsNull() {
+		t.Errorf("expected removed fields to be null, got %s %s", actual.EvtGracePeriod, actual.SharedRedemptionUrl)
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &LinkedTermResource{}
+	_ resource.ResourceWithModifyPlan  = &LinkedTermResource{}
+	_ resource.ResourceWithImportState = &LinkedTermResource{}
+)
+
+// LinkedTermResource defines the resource implementation.
+type LinkedTermResource struct {
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	maxRetries int
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
+}
+
+func NewLinkedTermResource() resource.Resource {
+	return &LinkedTermResource{}
+}
+
+type LinkedTermResourceModel struct {
+	Aid                       types.String   `tfsdk:"aid"`                         // The application ID
+	TermId                    types.String   `tfsdk:"term_id"`                     // The term ID
+	Rid                       types.String   `tfsdk:"rid"`                         // The resource ID
+	Name                      types.String   `tfsdk:"name"`                        // The term name
+	Description               types.String   `tfsdk:"description"`                 // The description of the term
+	ExternalProductIds        []types.String `tfsdk:"external_product_ids"`        // The external products of the external system accessed by users
+	SubscriptionManagementUrl types.String   `tfsdk:"subscription_management_url"` // The URL of the page where users manage their subscriptions in the external system
+	// read only
+	Type          types.String `tfsdk:"type"`            // The term type
+	CreateDate    types.Int64  `tfsdk:"create_date"`     // The creation date
+	UpdateDate    types.Int64  `tfsdk:"update_date"`     // The update date
+	CreateDateIso types.String `tfsdk:"create_date_iso"` // The creation date in RFC3339 format
+	UpdateDateIso types.String `tfsdk:"update_date_iso"` // The update date in RFC3339 format
+	Timeouts      types.Object `tfsdk:"timeouts"`        // The timeouts of the operations
+}
+
+func (r *LinkedTermResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_linked_term"
+}
+
+func (*LinkedTermResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "LinkedTerm resource. Linked term is a term whose subscriptions are sold and managed by an external system such as an app store. " +
+			"For more details, see https://docs.piano.io/linked-term/",
+		Attributes: map[string]schema.Attribute{
+			"aid": defaultAidAttribute(),
+			"term_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The term ID",
+			},
+			"rid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The resource ID",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The term name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the term",
+			},
+			"external_product_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The <a href=\"https://docs.piano.io/linked-term/#external-product\">external products</a> of the external system accessed by users, " +
+					"such as `[\"digital_prod\", \"print_sub_access\"]`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					// piano.io API takes the list as a comma-separated string
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]+$`), "must be a non-empty external product ID without comma"),
+					),
+				},
+			},
+			"subscription_management_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL of the page where users manage their subscriptions in the external system",
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The term type",
+			},
+			"create_date": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The creation date",
+			},
+			"create_date_iso": unixTimeIsoAttribute("The creation date"),
+			"update_date_iso": unixTimeIsoAttribute("The update date"),
+			"update_date": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The update date",
+			},
+			"timeouts": timeoutsAttribute(),
+		},
+	}
+}
+
+func (r *LinkedTermResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
+	r.maxRetries = client.maxRetries
+}
+
+func (r *LinkedTermResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planTermType(ctx, piano_publisher.TermTypeLinked, req, resp)
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "create_date", "update_date")
+}
+
+func (r *LinkedTermResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state LinkedTermResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutCreate)
+	defer cancel()
+	tflog.Info(ctx, fmt.Sprintf("creating linked term %s in %s", state.Name.ValueString(), state.Aid.ValueString()))
+	termId := r.configure(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The term created a moment ago may not be visible yet.
+	var data *piano_publisher.Term
+	found := retryUntilFound(ctx, r.maxRetries, func() bool {
+		var found bool
+		data, found = r.fetch(ctx, termId, &resp.Diagnostics)
+		return found || resp.Diagnostics.HasError()
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Term Not Found", fmt.Sprintf("Linked term %s was created, but not found", termId))
+		return
+	}
+	state = LinkedTermResourceModelFrom(state, *data, r.dateLocation)
+	tflog.Info(ctx, fmt.Sprintf("complete creating linked term %s(id: %s)", state.Name, state.TermId))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LinkedTermResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LinkedTermResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutRead)
+	defer cancel()
+	data, found := r.fetch(ctx, state.TermId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Linked term %s not found. Removing it from state", state.TermId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
+	}
+	state = LinkedTermResourceModelFrom(state, *data, r.dateLocation)
+	tflog.Trace(ctx, "read a linked term")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LinkedTermResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state LinkedTermResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutUpdate)
+	defer cancel()
+	tflog.Info(ctx, fmt.Sprintf("updating linked term %s in %s", state.TermId.ValueString(), state.Aid.ValueString()))
+	r.configure(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data, found := r.fetch(ctx, state.TermId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Term Not Found", fmt.Sprintf("Linked term %s not found", state.TermId.ValueString()))
+		return
+	}
+	state = LinkedTermResourceModelFrom(state, *data, r.dateLocation)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LinkedTermResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LinkedTermResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutDelete)
+	defer cancel()
+	tflog.Info(ctx, fmt.Sprintf("deleting linked term %s in %s", state.TermId.ValueString(), state.Aid.ValueString()))
+	response, err := r.client.PostPublisherTermDeleteWithFormdataBody(ctx, piano_publisher.PostPublisherTermDeleteFormdataRequestBody{
+		TermId: state.TermId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete linked term, got error: %s", err))
+		return
+	}
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+}
+
+func (r *LinkedTermResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := TermResourceIdFromString(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Term resource id", fmt.Sprintf("Unable to parse linked term resource id, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aid"), id.Aid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("term_id"), id.TermId)...)
+}
+
+// linkedTermConfiguration is the JSON body of /publisher/linkedTerm/configuration.
+// The endpoint creates a linked term when term_id is empty and updates the term otherwise.
+// See https://docs.piano.io/linked-term-schemas-and-examples/#create-update-linked-term
+type linkedTermConfiguration struct {
+	TermId                    string `json:"term_id,omitempty"`
+	Rid                       string `json:"rid"`
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	ExternalProductIds        string `json:"external_product_ids"`
+	SubscriptionManagementUrl string `json:"subscription_management_url"`
+}
+
+// configure creates or updates the linked term and returns its term ID.
+func (r *LinkedTermResource) configure(ctx context.Context, state LinkedTermResourceModel, diagnostics *diag.Diagnostics) string {
+	body, err := json.Marshal(linkedTermConfiguration{
+		TermId:                    state.TermId.ValueString(),
+		Rid:                       state.Rid.ValueString(),
+		Name:                      state.Name.ValueString(),
+		Description:               state.Description.ValueString(),
+		ExternalProductIds:        externalProductIdsStringFrom(state.ExternalProductIds),
+		SubscriptionManagementUrl: state.SubscriptionManagementUrl.ValueString(),
+	})
+	if err != nil {
+		diagnostics.AddError("Encode Error", fmt.Sprintf("Unable to encode linked term configuration, got error: %s", err))
+		return ""
+	}
+	// The linked term parameters are sent as JSON body, so aid is sent as a query parameter.
+	response, err := r.client.PostPublisherLinkedTermConfigurationWithBody(ctx, "application/json", bytes.NewReader(body), func(ctx context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Set("aid", state.Aid.ValueString())
+		req.URL.RawQuery = query.Encode()
+		return nil
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure linked term, got error: %s", err))
+		return ""
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, diagnostics)
+	if err != nil {
+		return ""
+	}
+	result := piano_publisher.StringResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return ""
+	}
+	if result.Data == "" {
+		return state.TermId.ValueString()
+	}
+	return result.Data
+}
+
+// fetch fetches the linked term. It reports false when the term is not found.
+func (r *LinkedTermResource) fetch(ctx context.Context, termId string, diagnostics *diag.Diagnostics) (*piano_publisher.Term, bool) {
+	response, err := r.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
+		TermId: termId,
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch term, got error: %s", err))
+		return nil, false
+	}
+	anyResponse, err := syntax.AnyResponseFrom(response, diagnostics)
+	if err != nil {
+		return nil, false
+	}
+	if errors.Is(anyResponse.Err(), syntax.ErrNotFound) {
+		return nil, false
+	}
+	if anyResponse.Code != 0 {
+		syntax.AddStatusError(anyResponse, diagnostics)
+		return nil, false
+	}
+	result := piano_publisher.TermResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return nil, false
+	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, diagnostics) {
+		return nil, false
+	}
+	return &result.Term, true
+}
+
+// LinkedTermResourceModelFrom updates state with the linked term returned by piano.io API, formatting the `*_iso` attributes in location.
+func LinkedTermResourceModelFrom(state LinkedTermResourceModel, data piano_publisher.Term, location *time.Location) LinkedTermResourceModel {
+	state.Aid = types.StringValue(data.Aid)
+	state.TermId = types.StringValue(data.TermId)
+	state.Rid = types.StringValue(data.Resource.Rid)
+	state.Name = types.StringValue(data.Name)
+	state.Description = syntax.ReconcileOptionalString(state.Description, &data.Description)
+	state.ExternalProductIds = externalProductIdsFrom(data.ExternalProductIds)
+	state.SubscriptionManagementUrl = syntax.ReconcileOptionalString(state.SubscriptionManagementUrl, &data.SubscriptionManagementUrl)
+	state.Type = types.StringValue(string(data.Type))
+	state.CreateDate = types.Int64Value(int64(data.CreateDate))
+	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, location)
+	state.UpdateDateIso = unixTimeIsoFrom(state.UpdateDate, location)
+	return state
+}
+
+// externalProductIdsFrom splits comma-separated external product IDs returned by piano.io API.
+func externalProductIdsFrom(externalProductIds *string) []types.String {
+	ret := []types.String{}
+	if externalProductIds == nil {
+		return ret
+	}
+	for _, id := range strings.Split(*externalProductIds, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ret = append(ret, types.StringValue(id))
+		}
+	}
+	return ret
+}
+
+// externalProductIdsStringFrom joins external product IDs into the comma-separated form piano.io API accepts.
+func externalProductIdsStringFrom(externalProductIds []types.String) string {
+	ids := []string{}
+	for _, id := range externalProductIds {
+		ids = append(ids, id.ValueString())
+	}
+	return strings.Join(ids, ",")
+}
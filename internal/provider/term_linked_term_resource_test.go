@@ -0,0 +1,171 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLinkedTermResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	backoff := retryBaseBackoff
+	retryBaseBackoff = 0
+	defer func() { retryBaseBackoff = backoff }()
+
+	fetched := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/publisher/linkedTerm/configuration":
+			if aid := req.URL.Query().Get("aid"); aid != "AIDXXXXXXX" {
+				t.Errorf("expected aid query AIDXXXXXXX, got %s", aid)
+			}
+			var body linkedTermConfiguration
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			expected := linkedTermConfiguration{Rid: "RXXXXXX", Name: "App Store", ExternalProductIds: "digital_prod,print_sub_access"}
+			if body != expected {
+				t.Errorf("expected body %+v, got %+v", expected, body)
+			}
+			fmt.Fprint(w, `{"code":0,"data":"TMLINKED"}`)
+		case "/publisher/term/get":
+			if termId := req.URL.Query().Get("term_id"); termId != "TMLINKED" {
+				t.Errorf("expected term_id TMLINKED, got %s", termId)
+			}
+			// the term created a moment ago is not visible yet
+			fetched++
+			if fetched == 1 {
+				fmt.Fprint(w, `{"code":1001,"message":"Term not found"}`)
+				return
+			}
+			fmt.Fprint(w, `{"code":0,"term":{"aid":"AIDXXXXXXX","term_id":"TMLINKED","type":"linked","name":"App Store","description":"",`+
+				`"external_product_ids":"digital_prod, print_sub_access","subscription_management_url":"","resource":{"rid":"RXXXXXX"},"create_date":1735657200,"update_date":1735657200}}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &LinkedTermResource{client: client, maxRetries: defaultMaxRetries}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &LinkedTermResourceModel{
+		Aid:                       types.StringValue("AIDXXXXXXX"),
+		TermId:                    types.StringUnknown(),
+		Rid:                       types.StringValue("RXXXXXX"),
+		Name:                      types.StringValue("App Store"),
+		Description:               types.StringNull(),
+		ExternalProductIds:        []types.String{types.StringValue("digital_prod"), types.StringValue("print_sub_access")},
+		SubscriptionManagementUrl: types.StringNull(),
+		Type:                      types.StringValue("linked"),
+		CreateDate:                types.Int64Unknown(),
+		UpdateDate:                types.Int64Unknown(),
+		Timeouts:                  timeoutsNull(),
+	})
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual LinkedTermResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if fetched != 2 {
+		t.Errorf("expected the term to be fetched twice, got %d", fetched)
+	}
+	if actual.TermId.ValueString() != "TMLINKED" {
+		t.Errorf("expected term_id TMLINKED, got %s", actual.TermId)
+	}
+	if !actual.Description.IsNull() || !actual.SubscriptionManagementUrl.IsNull() {
+		t.Errorf("expected unset attributes to be kept null, got %s %s", actual.Description, actual.SubscriptionManagementUrl)
+	}
+	expected := []types.String{types.StringValue("digital_prod"), types.StringValue("print_sub_access")}
+	if !reflect.DeepEqual(actual.ExternalProductIds, expected) {
+		t.Errorf("expected external_product_ids %v, got %v", expected, actual.ExternalProductIds)
+	}
+}
+
+func TestLinkedTermResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path != "/publisher/term/get" {
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+		fmt.Fprint(w, `{"code":1001,"message":"Term not found"}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &LinkedTermResource{client: client, maxRetries: defaultMaxRetries}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := newTestState(t, schemaResp.Schema, &LinkedTermResourceModel{
+		Aid:                       types.StringValue("AIDXXXXXXX"),
+		TermId:                    types.StringValue("TMLINKED"),
+		Rid:                       types.StringValue("RXXXXXX"),
+		Name:                      types.StringValue("App Store"),
+		Description:               types.StringNull(),
+		ExternalProductIds:        []types.String{types.StringValue("digital_prod")},
+		SubscriptionManagementUrl: types.StringNull(),
+		Type:                      types.StringValue("linked"),
+		CreateDate:                types.Int64Value(1735657200),
+		UpdateDate:                types.Int64Value(1735657200),
+		CreateDateIso:             types.StringValue("2024-12-31T15:00:00Z"),
+		UpdateDateIso:             types.StringValue("2024-12-31T15:00:00Z"),
+		Timeouts:                  timeoutsNull(),
+	})
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the linked term deleted outside terraform to be removed from state, got %v", resp.State.Raw)
+	}
+}
+
+func TestExternalProductIdsRoundTrip(t *testing.T) {
+	str := func(s string) *string { return &s }
+	cases := []struct {
+		input    *string
+		expected []types.String
+	}{
+		{input: nil, expected: []types.String{}},
+		{input: new(string), expected: []types.String{}},
+		{input: str("digital_prod"), expected: []types.String{types.StringValue("digital_prod")}},
+		{input: str("digital_prod, print_sub_access,"), expected: []types.String{types.StringValue("digital_prod"), types.StringValue("print_sub_access")}},
+	}
+	for _, c := range cases {
+		actual := externalProductIdsFrom(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, actual)
+		}
+	}
+	if actual := externalProductIdsStringFrom([]types.String{types.StringValue("digital_prod"), types.StringValue("print_sub_access")}); actual != "digital_prod,print_sub_access" {
+		t.Errorf("expected digital_prod,print_sub_access, got %s", actual)
+	}
+}
@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -55,8 +59,10 @@ type PaymentTermResourceModel struct {
 	BillingConfig                         types.String                    `tfsdk:"billing_config"` // The type of billing config
 	ChangeOptions                         []TermChangeOptionResourceModel `tfsdk:"change_options"`
 	CollectAddress                        types.Bool                      `tfsdk:"collect_address"`                              // Whether to collect an address for this term
+	CollectShippingAddress                types.Bool                      `tfsdk:"collect_shipping_address"`                     // Whether to collect a shipping address for this gift term
 	CreateDate                            types.Int64                     `tfsdk:"create_date"`                                  // The creation date
 	CurrencySymbol                        types.String                    `tfsdk:"currency_symbol"`                              // The currency symbol
+	DeliveryZone                          types.Set                       `tfsdk:"delivery_zone"`                                // The delivery zone IDs of the term
 	Description                           types.String                    `tfsdk:"description"`                                  // The description of the term
 	EvtVerificationPeriod                 types.Int32                     `tfsdk:"evt_verification_period"`                      // The <a href = "https://docs.piano.io/external-service-term/#externaltermverification">periodicity</a> (in seconds) of checking the EVT subscription with the external service
 	IsAllowedToChangeSchedulePeriodInPast types.Bool                      `tfsdk:"is_allowed_to_change_schedule_period_in_past"` // Whether the term allows to change its schedule period created previously
@@ -79,12 +85,17 @@ type PaymentTermResourceModel struct {
 	Resource                              *ResourceResourceModel          `tfsdk:"resource"`
 	Schedule                              *ScheduleResourceModel          `tfsdk:"schedule"`
 	ScheduleBilling                       types.String                    `tfsdk:"schedule_billing"`        // The schedule billing
+	SharedAccountCount                    types.Int32                     `tfsdk:"shared_account_count"`    // The count of allowed shared-subscription accounts
 	SharedRedemptionUrl                   types.String                    `tfsdk:"shared_redemption_url"`   // The shared subscription redemption URL
 	TermBillingDescriptor                 types.String                    `tfsdk:"term_billing_descriptor"` // The term billing descriptor
 	TermId                                types.String                    `tfsdk:"term_id"`                 // The term ID
+	Timeouts                              types.Object                    `tfsdk:"timeouts"`                // The timeouts of the operations
 	Type                                  types.String                    `tfsdk:"type"`                    // The term type
 	UpdateDate                            types.Int64                     `tfsdk:"update_date"`             // The update date
+	CreateDateIso                         types.String                    `tfsdk:"create_date_iso"`         // The creation date in RFC3339 format
+	UpdateDateIso                         types.String                    `tfsdk:"update_date_iso"`         // The update date in RFC3339 format
 	VerifyOnRenewal                       types.Bool                      `tfsdk:"verify_on_renewal"`       // Whether the term should be verified before renewal (if "FALSE", this step is skipped)
+	VoucheringPolicy                      *VoucheringPolicyResourceModel  `tfsdk:"vouchering_policy"`
 }
 
 type TermChangeOptionResourceModel struct {
@@ -140,6 +151,7 @@ type PaymentBillingPlanTableResourceModel struct {
 
 var (
 	_ resource.Resource                = &PaymentTermResource{}
+	_ resource.ResourceWithModifyPlan  = &PaymentTermResource{}
 	_ resource.ResourceWithImportState = &PaymentTermResource{}
 )
 
@@ -149,7 +161,10 @@ func NewPaymentTermResource() resource.Resource {
 
 // TermDataSource defines the data source implementation.
 type PaymentTermResource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
 }
 
 func (r *PaymentTermResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -157,32 +172,31 @@ func (r *PaymentTermResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *PaymentTermResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(PianoProviderData)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
+}
 
+func (r *PaymentTermResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	r.client = &client.publisherClient
+	planTermType(ctx, piano_publisher.TermTypePayment, req, resp)
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "create_date", "update_date")
 }
 
 func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Payment Term resource. Payment term is a term that is used to create a payment.",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"term_id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -368,7 +382,18 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Whether to collect an address for this term",
 			},
+			"collect_shipping_address": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether to collect a shipping address for this gift term. piano.io API accepts `collect_shipping_address` only on the gift term endpoints, so this attribute is read only.",
+			},
+			"delivery_zone": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The delivery zone IDs of the term",
+			},
 
+			"create_date_iso": unixTimeIsoAttribute("The creation date"),
+			"update_date_iso": unixTimeIsoAttribute("The update date"),
 			"update_date": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The update date",
@@ -392,6 +417,31 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:             stringdefault.StaticString(""),
 				MarkdownDescription: "The type of billing config",
 			},
+			"vouchering_policy": schema.SingleNestedAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The vouchering policy of the term. piano.io accepts vouchering policies only on gift term endpoints, so this attribute is read only.",
+				Attributes: map[string]schema.Attribute{
+					"vouchering_policy_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The vouchering policy ID",
+					},
+					"vouchering_policy_billing_plan": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The billing plan of the vouchering policy",
+					},
+					"vouchering_policy_billing_plan_description": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The description of the vouchering policy billing plan",
+					},
+					"vouchering_policy_redemption_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The vouchering policy redemption URL",
+					},
+				},
+			},
 			"verify_on_renewal": schema.BoolAttribute{
 				Optional:            true,
 				Default:             booldefault.StaticBool(false),
@@ -477,6 +527,26 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 							stringvalidator.OneOf("standard", "bundle", "print"),
 						},
 					},
+					"type_label": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "The resource type label ('Standard', 'Bundle' or 'Print')",
+						Validators: []validator.String{
+							stringvalidator.OneOf("Standard", "Bundle", "Print"),
+						},
+					},
+					"bundle_type_label": schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "The bundle type label ('Fixed', 'Fixed 2.0', 'Tagged' or 'Undefined'). This value is null for non-bundle resources.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("Fixed", "Fixed 2.0", "Tagged", "Undefined"),
+						},
+					},
 					"deleted": schema.BoolAttribute{
 						Computed: true,
 						PlanModifiers: []planmodifier.Bool{
@@ -512,6 +582,13 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 						},
 						MarkdownDescription: "The external ID; defined by the client",
 					},
+					"published": schema.BoolAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "Whether the resource is published",
+					},
 					"publish_date": schema.Int64Attribute{
 						Computed: true,
 						PlanModifiers: []planmodifier.Int64{
@@ -607,6 +684,10 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:             stringdefault.StaticString(""),
 				MarkdownDescription: "The currency of the term",
 			},
+			"shared_account_count": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: "The count of allowed shared-subscription accounts",
+			},
 			"shared_redemption_url": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The shared subscription redemption URL",
@@ -620,7 +701,6 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 			"product_category": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(""),
 				MarkdownDescription: "The product category",
 			},
 			"type": schema.StringAttribute{
@@ -639,6 +719,8 @@ func (*PaymentTermResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:             int32default.StaticInt32(0),
 				MarkdownDescription: "The number of days after expiration to still allow access to the resource",
 			},
+			// piano_payment_term does not create or update terms, so only the read and delete timeouts take effect.
+			"timeouts": timeoutsAttribute(),
 		},
 	}
 }
@@ -715,6 +797,7 @@ func ScheduleResourceModelFrom(data piano_publisher.Schedule) ScheduleResourceMo
 }
 
 func PaymentBillingPlanTableResourceModelFrom(data piano_publisher.PaymentBillingPlanTable) PaymentBillingPlanTableResourceModel {
+	data = completeBillingPlanTablePrices(data)
 	ret := PaymentBillingPlanTableResourceModel{}
 	ret.IsTrial = types.StringPointerValue(data.IsTrial)
 	ret.Cycles = types.StringPointerValue(data.Cycles)
@@ -729,7 +812,9 @@ func PaymentBillingPlanTableResourceModelFrom(data piano_publisher.PaymentBillin
 	ret.Billing = types.StringPointerValue(data.Billing)
 	ret.BillingInfo = types.StringPointerValue(data.BillingInfo)
 	ret.Date = types.StringPointerValue(data.Date)
-	// ret.DateValue = types.Int64PointerValue(int64(data.DateValue))
+	if data.DateValue != nil {
+		ret.DateValue = types.Int64Value(int64(*data.DateValue))
+	}
 	ret.BillingPeriod = types.StringPointerValue(data.BillingPeriod)
 	ret.IsFreeTrial = types.StringPointerValue(data.IsFreeTrial)
 	ret.Price = types.StringPointerValue(data.Price)
@@ -738,6 +823,43 @@ func PaymentBillingPlanTableResourceModelFrom(data piano_publisher.PaymentBillin
 	ret.IsFree = types.StringPointerValue(data.IsFree)
 	return ret
 }
+
+func PaymentBillingPlanTableAttrType() attr.Type {
+	return basetypes.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"billing":                     types.StringType,
+			"billing_info":                types.StringType,
+			"billing_period":              types.StringType,
+			"currency":                    types.StringType,
+			"cycles":                      types.StringType,
+			"date":                        types.StringType,
+			"date_value":                  types.Int64Type,
+			"duration":                    types.StringType,
+			"is_free":                     types.StringType,
+			"is_free_trial":               types.StringType,
+			"is_pay_what_you_want":        types.StringType,
+			"is_trial":                    types.StringType,
+			"period":                      types.StringType,
+			"price":                       types.StringType,
+			"price_and_tax":               types.Float64Type,
+			"price_and_tax_in_minor_unit": types.Float32Type,
+			"price_charged_str":           types.StringType,
+			"price_value":                 types.Float64Type,
+			"short_period":                types.StringType,
+			"total_billing":               types.StringType,
+		},
+	}
+}
+
+// PaymentBillingPlanTableListFrom converts the billing plan table of a term into a list value.
+func PaymentBillingPlanTableListFrom(ctx context.Context, data []piano_publisher.PaymentBillingPlanTable) (types.List, diag.Diagnostics) {
+	elements := []PaymentBillingPlanTableResourceModel{}
+	for _, element := range data {
+		elements = append(elements, PaymentBillingPlanTableResourceModelFrom(element))
+	}
+	return types.ListValueFrom(ctx, PaymentBillingPlanTableAttrType(), elements)
+}
+
 func VoucheringPolicyResourceModelFrom(data piano_publisher.VoucheringPolicy) VoucheringPolicyResourceModel {
 	ret := VoucheringPolicyResourceModel{}
 	ret.VoucheringPolicyRedemptionUrl = types.StringValue(data.VoucheringPolicyRedemptionUrl)
@@ -747,6 +869,34 @@ func VoucheringPolicyResourceModelFrom(data piano_publisher.VoucheringPolicy) Vo
 	return ret
 }
 
+func VoucheringPolicyAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"vouchering_policy_billing_plan":             types.StringType,
+		"vouchering_policy_billing_plan_description": types.StringType,
+		"vouchering_policy_id":                       types.StringType,
+		"vouchering_policy_redemption_url":           types.StringType,
+	}
+}
+
+// VoucheringPolicyObjectFrom converts the vouchering policy of a term into an object value, which is null when the term has none.
+func VoucheringPolicyObjectFrom(ctx context.Context, data *piano_publisher.VoucheringPolicy) (types.Object, diag.Diagnostics) {
+	if data == nil {
+		return types.ObjectNull(VoucheringPolicyAttrTypes()), nil
+	}
+	return types.ObjectValueFrom(ctx, VoucheringPolicyAttrTypes(), VoucheringPolicyResourceModelFrom(*data))
+}
+
+// DeliveryZoneIdsFrom returns the IDs of the delivery zones of a term.
+func DeliveryZoneIdsFrom(data *[]piano_publisher.DeliveryZone) []string {
+	ret := []string{}
+	if data == nil {
+		return ret
+	}
+	for _, element := range *data {
+		ret = append(ret, element.DeliveryZoneId)
+	}
+	return ret
+}
 func TermBriefResourceModelFrom(data piano_publisher.TermBrief) TermBriefResourceModel {
 	ret := TermBriefResourceModel{}
 	ret.Disabled = types.BoolValue(data.Disabled)
@@ -759,6 +909,7 @@ func ResourceResourceModelFrom(data piano_publisher.Resource) ResourceResourceMo
 	ret.Disabled = types.BoolValue(data.Disabled)
 	ret.ResourceUrl = types.StringPointerValue(data.ResourceUrl)
 	ret.PublishDate = types.Int64Value(int64(data.PublishDate))
+	ret.Published = types.BoolValue(isResourcePublished(int64(data.PublishDate), time.Now()))
 	ret.ExternalId = types.StringPointerValue(data.ExternalId)
 	ret.IsFbiaResource = types.BoolValue(data.IsFbiaResource)
 	ret.CreateDate = types.Int64Value(int64(data.CreateDate))
@@ -771,7 +922,9 @@ func ResourceResourceModelFrom(data piano_publisher.Resource) ResourceResourceMo
 	ret.Aid = types.StringValue(data.Aid)
 	ret.PurchaseUrl = types.StringPointerValue(data.PurchaseUrl)
 	ret.ImageUrl = types.StringPointerValue(data.ImageUrl)
+	ret.TypeLabel = types.StringValue(string(data.TypeLabel))
 	ret.BundleType = types.StringPointerValue((*string)(data.BundleType))
+	ret.BundleTypeLabel = types.StringPointerValue((*string)(data.BundleTypeLabel))
 	return ret
 }
 
@@ -789,6 +942,8 @@ func (r *PaymentTermResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutRead)
+	defer cancel()
 
 	response, err := r.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
 		TermId: state.TermId.ValueString(),
@@ -808,15 +963,19 @@ func (r *PaymentTermResource) Read(ctx context.Context, req resource.ReadRequest
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, &resp.Diagnostics) {
+		return
+	}
 
 	data := result.Term
 
 	state.PaymentRenewGracePeriod = types.Int32Value(data.PaymentRenewGracePeriod)
 
 	state.Type = types.StringValue(string(data.Type))
-	state.ProductCategory = types.StringValue(data.ProductCategory)
+	state.ProductCategory = syntax.ReconcileOptionalString(state.ProductCategory, &data.ProductCategory)
 	state.CurrencySymbol = types.StringValue(data.CurrencySymbol)
-	state.SharedRedemptionUrl = types.StringPointerValue(data.SharedRedemptionUrl)
+	state.SharedAccountCount = syntax.ReconcileOptionalInt32(state.SharedAccountCount, data.SharedAccountCount)
+	state.SharedRedemptionUrl = syntax.ReconcileOptionalString(state.SharedRedemptionUrl, data.SharedRedemptionUrl)
 	state.PaymentCurrency = types.StringValue(data.PaymentCurrency)
 	state.PaymentTrialNewCustomersOnly = types.BoolValue(data.PaymentTrialNewCustomersOnly)
 	if data.Schedule != nil {
@@ -836,13 +995,22 @@ func (r *PaymentTermResource) Read(ctx context.Context, req resource.ReadRequest
 	state.PaymentNewCustomersOnly = types.BoolValue(data.PaymentNewCustomersOnly)
 	state.TermBillingDescriptor = types.StringValue(data.TermBillingDescriptor)
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
+	state.UpdateDateIso = unixTimeIsoFrom(state.UpdateDate, r.dateLocation)
 	state.CollectAddress = types.BoolValue(data.CollectAddress)
+	state.CollectShippingAddress = types.BoolPointerValue(data.CollectShippingAddress)
+	deliveryZone, diags := types.SetValueFrom(ctx, types.StringType, DeliveryZoneIdsFrom(data.DeliveryZone))
+	resp.Diagnostics.Append(diags...)
+	state.DeliveryZone = deliveryZone
 	state.ScheduleBilling = types.StringPointerValue(data.ScheduleBilling)
 	state.PaymentHasFreeTrial = types.BoolValue(data.PaymentHasFreeTrial)
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
+	}
 	state.Aid = types.StringValue(data.Aid)
 
 	changeOptionsElements := []TermChangeOptionResourceModel{}
-	for _, element := range data.ChangeOptions {
+	for _, element := range sortedTermChangeOptions(data.ChangeOptions) {
 		changeOptionsElements = append(changeOptionsElements, TermChangeOptionResourceModelFrom(element))
 	}
 	state.ChangeOptions = changeOptionsElements
@@ -858,6 +1026,11 @@ func (r *PaymentTermResource) Read(ctx context.Context, req resource.ReadRequest
 	state.PaymentAllowPromoCodes = types.BoolValue(data.PaymentAllowPromoCodes)
 	state.Description = types.StringValue(data.Description)
 	state.PaymentAllowRenewDays = types.Int32Value(data.PaymentAllowRenewDays)
+	state.VoucheringPolicy = nil
+	if data.VoucheringPolicy != nil {
+		VoucheringPolicy := VoucheringPolicyResourceModelFrom(*data.VoucheringPolicy)
+		state.VoucheringPolicy = &VoucheringPolicy
+	}
 
 	tflog.Trace(ctx, "read a resource")
 
@@ -870,6 +1043,8 @@ func (r *PaymentTermResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutDelete)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("deleting Term %s:%s in $%s", state.Name.ValueString(), state.TermId.ValueString(), state.Aid.ValueString()))
 	response, err := r.client.PostPublisherTermDeleteWithFormdataBody(ctx, piano_publisher.PostPublisherTermDeleteFormdataRequestBody{
@@ -879,7 +1054,7 @@ func (r *PaymentTermResource) Delete(ctx context.Context, req resource.DeleteReq
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -6,11 +6,19 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"terraform-provider-piano/internal/piano_publisher"
 	"terraform-provider-piano/internal/syntax"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,42 +37,69 @@ import (
 )
 
 type PaymentTermV2ResourceModel struct {
-	Aid                                   types.String           `tfsdk:"aid"`                                          // The application ID
-	Rid                                   types.String           `tfsdk:"rid"`                                          // The resource ID
-	CollectAddress                        types.Bool             `tfsdk:"collect_address"`                              // Whether to collect an address for this term
-	CreateDate                            types.Int64            `tfsdk:"create_date"`                                  // The creation date
-	CurrencySymbol                        types.String           `tfsdk:"currency_symbol"`                              // The currency symbol
-	Description                           types.String           `tfsdk:"description"`                                  // The description of the term
-	EvtVerificationPeriod                 types.Int32            `tfsdk:"evt_verification_period"`                      // The <a href = "https://docs.piano.io/external-service-term/#externaltermverification">periodicity</a> (in seconds) of checking the EVT subscription with the external service
-	IsAllowedToChangeSchedulePeriodInPast types.Bool             `tfsdk:"is_allowed_to_change_schedule_period_in_past"` // Whether the term allows to change its schedule period created previously
-	Name                                  types.String           `tfsdk:"name"`                                         // The term name
-	PaymentAllowGift                      types.Bool             `tfsdk:"payment_allow_gift"`                           // Whether the term can be gifted
-	PaymentAllowPromoCodes                types.Bool             `tfsdk:"payment_allow_promo_codes"`                    // Whether to allow promo codes to be applied
-	PaymentAllowRenewDays                 types.Int32            `tfsdk:"payment_allow_renew_days"`                     // How many days in advance users user can renew
-	PaymentBillingPlan                    types.String           `tfsdk:"payment_billing_plan"`                         // The billing plan for the term
-	PaymentBillingPlanDescription         types.String           `tfsdk:"payment_billing_plan_description"`             // The description of the term billing plan
-	PaymentCurrency                       types.String           `tfsdk:"payment_currency"`                             // The currency of the term
-	PaymentFirstPrice                     types.Float64          `tfsdk:"payment_first_price"`                          // The first price of the term
-	PaymentForceAutoRenew                 types.Bool             `tfsdk:"payment_force_auto_renew"`                     // Prevents users from disabling autorenewal (always "TRUE" for dynamic terms)
-	PaymentHasFreeTrial                   types.Bool             `tfsdk:"payment_has_free_trial"`                       // Whether payment includes a free trial
-	PaymentIsCustomPriceAvailable         types.Bool             `tfsdk:"payment_is_custom_price_available"`            // Whether users can pay more than term price
-	PaymentNewCustomersOnly               types.Bool             `tfsdk:"payment_new_customers_only"`                   // Whether to show the term only to users having no dynamic or purchase conversions yet
-	PaymentRenewGracePeriod               types.Int32            `tfsdk:"payment_renew_grace_period"`                   // The number of days after expiration to still allow access to the resource
-	PaymentTrialNewCustomersOnly          types.Bool             `tfsdk:"payment_trial_new_customers_only"`             // Whether to allow trial period only to users having no purchases yet
-	ProductCategory                       types.String           `tfsdk:"product_category"`                             // The product category
-	Schedule                              *ScheduleResourceModel `tfsdk:"schedule"`
-	ScheduleBilling                       types.String           `tfsdk:"schedule_billing"`      // The schedule billing
-	SharedAccountCount                    types.Int32            `tfsdk:"shared_account_count"`  // The shared account count
-	SharedRedemptionUrl                   types.String           `tfsdk:"shared_redemption_url"` // The shared subscription redemption URL
-	TermId                                types.String           `tfsdk:"term_id"`               // The term ID
-	Type                                  types.String           `tfsdk:"type"`                  // The term type
-	UpdateDate                            types.Int64            `tfsdk:"update_date"`           // The update date
-	VerifyOnRenewal                       types.Bool             `tfsdk:"verify_on_renewal"`     // Whether the term should be verified before renewal (if "FALSE", this step is skipped)
+	Aid                                   types.String                   `tfsdk:"aid"`                                          // The application ID
+	Rid                                   types.String                   `tfsdk:"rid"`                                          // The resource ID
+	AllowStartInFuture                    types.Bool                     `tfsdk:"allow_start_in_future"`                        // Allow start in the future
+	BillingConfiguration                  types.String                   `tfsdk:"billing_configuration"`                        // A JSON value representing a list of the access periods with billing configurations
+	ChangeOptions                         []PaymentTermChangeOptionModel `tfsdk:"change_options"`                               // The options to change from this term to other terms
+	CollectAddress                        types.Bool                     `tfsdk:"collect_address"`                              // Whether to collect an address for this term
+	CollectShippingAddress                types.Bool                     `tfsdk:"collect_shipping_address"`                     // Whether to collect a shipping address for this gift term
+	CreateDate                            types.Int64                    `tfsdk:"create_date"`                                  // The creation date
+	CurrencySymbol                        types.String                   `tfsdk:"currency_symbol"`                              // The currency symbol
+	DeliveryZone                          types.Set                      `tfsdk:"delivery_zone"`                                // The delivery zone IDs of the term
+	Description                           types.String                   `tfsdk:"description"`                                  // The description of the term
+	Disabled                              types.Bool                     `tfsdk:"disabled"`                                     // Whether the term is disabled
+	EvtVerificationPeriod                 types.Int32                    `tfsdk:"evt_verification_period"`                      // The <a href = "https://docs.piano.io/external-service-term/#externaltermverification">periodicity</a> (in seconds) of checking the EVT subscription with the external service
+	IsAllowedToChangeSchedulePeriodInPast types.Bool                     `tfsdk:"is_allowed_to_change_schedule_period_in_past"` // Whether the term allows to change its schedule period created previously
+	MaximumDaysInAdvance                  types.Int32                    `tfsdk:"maximum_days_in_advance"`                      // Maximum days in advance
+	Name                                  types.String                   `tfsdk:"name"`                                         // The term name
+	PaymentAllowGift                      types.Bool                     `tfsdk:"payment_allow_gift"`                           // Whether the term can be gifted
+	PaymentAllowPromoCodes                types.Bool                     `tfsdk:"payment_allow_promo_codes"`                    // Whether to allow promo codes to be applied
+	PaymentAllowRenewDays                 types.Int32                    `tfsdk:"payment_allow_renew_days"`                     // How many days in advance users user can renew
+	PaymentBillingPlan                    types.String                   `tfsdk:"payment_billing_plan"`                         // The billing plan for the term
+	PaymentBillingPlanDescription         types.String                   `tfsdk:"payment_billing_plan_description"`             // The description of the term billing plan
+	PaymentBillingPlanTable               types.List                     `tfsdk:"payment_billing_plan_table"`                   // The billing plan resolved from payment_billing_plan
+	PaymentCurrency                       types.String                   `tfsdk:"payment_currency"`                             // The currency of the term
+	PaymentFirstPrice                     types.Float64                  `tfsdk:"payment_first_price"`                          // The first price of the term
+	PaymentForceAutoRenew                 types.Bool                     `tfsdk:"payment_force_auto_renew"`                     // Prevents users from disabling autorenewal (always "TRUE" for dynamic terms)
+	PaymentHasFreeTrial                   types.Bool                     `tfsdk:"payment_has_free_trial"`                       // Whether payment includes a free trial
+	PaymentIsCustomPriceAvailable         types.Bool                     `tfsdk:"payment_is_custom_price_available"`            // Whether users can pay more than term price
+	PaymentNewCustomersOnly               types.Bool                     `tfsdk:"payment_new_customers_only"`                   // Whether to show the term only to users having no dynamic or purchase conversions yet
+	PaymentRenewGracePeriod               types.Int32                    `tfsdk:"payment_renew_grace_period"`                   // The number of days after expiration to still allow access to the resource
+	PaymentTrialNewCustomersOnly          types.Bool                     `tfsdk:"payment_trial_new_customers_only"`             // Whether to allow trial period only to users having no purchases yet
+	PaymentTrialPeriod                    types.String                   `tfsdk:"payment_trial_period"`                         // The period of the free trial
+	PaymentTrialPrice                     types.Float64                  `tfsdk:"payment_trial_price"`                          // The price of the free trial
+	ProductCategory                       types.String                   `tfsdk:"product_category"`                             // The product category
+	Schedule                              *ScheduleResourceModel         `tfsdk:"schedule"`
+	ScheduleBilling                       types.String                   `tfsdk:"schedule_billing"`       // The schedule billing
+	SharedAccountCount                    types.Int32                    `tfsdk:"shared_account_count"`   // The shared account count
+	SharedRedemptionUrl                   types.String                   `tfsdk:"shared_redemption_url"`  // The shared subscription redemption URL
+	ShowFullBillingPlan                   types.Bool                     `tfsdk:"show_full_billing_plan"` // Show full billing plan on checkout
+	TermId                                types.String                   `tfsdk:"term_id"`                // The term ID
+	Timeouts                              types.Object                   `tfsdk:"timeouts"`               // The timeouts of the operations
+	Type                                  types.String                   `tfsdk:"type"`                   // The term type
+	UpdateDate                            types.Int64                    `tfsdk:"update_date"`            // The update date
+	CreateDateIso                         types.String                   `tfsdk:"create_date_iso"`        // The creation date in RFC3339 format
+	UpdateDateIso                         types.String                   `tfsdk:"update_date_iso"`        // The update date in RFC3339 format
+	VerifyOnRenewal                       types.Bool                     `tfsdk:"verify_on_renewal"`      // Whether the term should be verified before renewal (if "FALSE", this step is skipped)
+	VoucheringPolicy                      types.Object                   `tfsdk:"vouchering_policy"`      // The vouchering policy of the term
+}
+
+// PaymentTermChangeOptionModel is a change option from the payment term managed in change_options.
+type PaymentTermChangeOptionModel struct {
+	TermChangeOptionId types.String `tfsdk:"term_change_option_id"` // The term change option ID
+	ToTermId           types.String `tfsdk:"to_term_id"`            // The term ID to change to
+	BillingTiming      types.String `tfsdk:"billing_timing"`        // The billing timing
+	ImmediateAccess    types.Bool   `tfsdk:"immediate_access"`      // Whether the access begins immediately
+	ProrateAccess      types.Bool   `tfsdk:"prorate_access"`        // Whether the prorate billing amount function is enabled
 }
 
 var (
-	_ resource.Resource                = &PaymentTermV2Resource{}
-	_ resource.ResourceWithImportState = &PaymentTermV2Resource{}
+	_ resource.Resource                   = &PaymentTermV2Resource{}
+	_ resource.ResourceWithModifyPlan     = &PaymentTermV2Resource{}
+	_ resource.ResourceWithImportState    = &PaymentTermV2Resource{}
+	_ resource.ResourceWithMoveState      = &PaymentTermV2Resource{}
+	_ resource.ResourceWithValidateConfig = &PaymentTermV2Resource{}
 )
 
 func NewPaymentTermV2Resource() resource.Resource {
@@ -72,7 +108,11 @@ func NewPaymentTermV2Resource() resource.Resource {
 
 // TermDataSource defines the data source implementation.
 type PaymentTermV2Resource struct {
-	client *piano_publisher.Client
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+	maxRetries int
+	// dateLocation is the location to format the `*_iso` attributes in. See unixTimeIsoFrom.
+	dateLocation *time.Location
 }
 
 func (r *PaymentTermV2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,32 +120,185 @@ func (r *PaymentTermV2Resource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *PaymentTermV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(PianoProviderData)
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+	r.dateLocation = client.dateLocation
+	r.maxRetries = client.maxRetries
+}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected PianoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (r *PaymentTermV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planTermType(ctx, piano_publisher.TermTypePayment, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planCurrencySymbol(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planBillingPlanDescription(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planChangeOptions(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planUnixTimeIso(ctx, req, resp, r.dateLocation, "create_date", "update_date")
+}
 
+// planChangeOptions keeps the IDs of the change options that are unchanged from the state.
+// piano.io API can neither update nor delete a change option, so the other change options are planned to be created.
+func planChangeOptions(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
 		return
 	}
+	var plan, state []PaymentTermChangeOptionModel
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("change_options"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("change_options"), &state)...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+	used := make([]bool, len(state))
+	for i := range plan {
+		if !plan[i].TermChangeOptionId.IsUnknown() {
+			continue
+		}
+		for j := range state {
+			if !used[j] && plan[i].sameAs(state[j]) {
+				plan[i].TermChangeOptionId = state[j].TermChangeOptionId
+				used[j] = true
+				break
+			}
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("change_options"), plan)...)
+}
 
-	r.client = &client.publisherClient
+// sameAs reports whether two change options are configured the same, ignoring their IDs.
+func (m PaymentTermChangeOptionModel) sameAs(other PaymentTermChangeOptionModel) bool {
+	return m.ToTermId.Equal(other.ToTermId) &&
+		m.BillingTiming.Equal(other.BillingTiming) &&
+		m.ImmediateAccess.Equal(other.ImmediateAccess) &&
+		m.ProrateAccess.Equal(other.ProrateAccess)
+}
+
+// planBillingPlanDescription keeps payment_billing_plan_description and payment_first_price from the state while the billing plan is unchanged.
+// piano.io API computes them from the billing plan, so they are otherwise planned as unknown on every update.
+func planBillingPlanDescription(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state struct {
+		billingPlan, trialPeriod types.String
+		trialPrice               types.Float64
+	}
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("payment_billing_plan"), &plan.billingPlan)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("payment_trial_period"), &plan.trialPeriod)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("payment_trial_price"), &plan.trialPrice)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("payment_billing_plan"), &state.billingPlan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("payment_trial_period"), &state.trialPeriod)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("payment_trial_price"), &state.trialPrice)...)
+	if resp.Diagnostics.HasError() || !plan.billingPlan.Equal(state.billingPlan) || !plan.trialPeriod.Equal(state.trialPeriod) || !plan.trialPrice.Equal(state.trialPrice) {
+		return
+	}
+	var description types.String
+	var firstPrice types.Float64
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("payment_billing_plan_description"), &description)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("payment_first_price"), &firstPrice)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("payment_billing_plan_description"), description)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("payment_first_price"), firstPrice)...)
+}
+
+// planCurrencySymbol plans currency_symbol as the symbol of payment_currency when currency_symbol is not configured.
+// The symbol is kept from the state while payment_currency is unchanged, and left unknown when payment_currency is not known yet.
+func planCurrencySymbol(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var currencySymbol, planned types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("currency_symbol"), &currencySymbol)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("payment_currency"), &planned)...)
+	if resp.Diagnostics.HasError() || !currencySymbol.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var prior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("payment_currency"), &prior)...)
+		if resp.Diagnostics.HasError() || planned.Equal(prior) {
+			return
+		}
+	}
+	symbol := types.StringUnknown()
+	if !planned.IsUnknown() {
+		symbol = types.StringValue(currencySymbolFrom(planned.ValueString()))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("currency_symbol"), symbol)...)
+}
+
+// currencySymbols maps common ISO 4217 currency codes to their symbols.
+var currencySymbols = map[string]string{
+	"AUD": "A$",
+	"BRL": "R$",
+	"CAD": "CA$",
+	"CHF": "CHF",
+	"CNY": "¥",
+	"CZK": "Kč",
+	"DKK": "kr",
+	"EUR": "€",
+	"GBP": "£",
+	"HKD": "HK$",
+	"ILS": "₪",
+	"INR": "₹",
+	"JPY": "¥",
+	"KRW": "₩",
+	"MXN": "MX$",
+	"NOK": "kr",
+	"NZD": "NZ$",
+	"PLN": "zł",
+	"SEK": "kr",
+	"SGD": "S$",
+	"THB": "฿",
+	"TRY": "₺",
+	"USD": "$",
+	"ZAR": "R",
+}
+
+// currencySymbolFrom returns the symbol of the currency, or the currency code itself for unknown currencies.
+func currencySymbolFrom(currency string) string {
+	if symbol, ok := currencySymbols[currency]; ok {
+		return symbol
+	}
+	return currency
+}
+
+// currencySymbolFromTerm returns the currency symbol of the term created or updated when it could not be planned.
+func currencySymbolFromTerm(term piano_publisher.Term) types.String {
+	if term.CurrencySymbol != "" {
+		return types.StringValue(term.CurrencySymbol)
+	}
+	return types.StringValue(currencySymbolFrom(term.PaymentCurrency))
 }
 
 func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Payment Term resource. Payment term is a term that is used to create a payment.",
+		MarkdownDescription: "Payment Term resource. Payment term is a term that is used to create a payment.\n\n" +
+			"Existing `piano_payment_term` resources can be migrated to this resource without re-creating the term with a `moved` block:\n\n" +
+			"```terraform\nmoved {\n  from = piano_payment_term.example\n  to   = piano_payment_term_v2.example\n}\n```",
 		Attributes: map[string]schema.Attribute{
-			"aid": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The application ID",
-			},
+			"aid": defaultAidAttribute(),
 			"rid": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The application ID",
@@ -127,6 +320,19 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             stringdefault.StaticString(""),
 				MarkdownDescription: "The description of the term",
 			},
+			"allow_start_in_future": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether users can choose a start date of the subscription in the future",
+			},
+			"maximum_days_in_advance": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				MarkdownDescription: "The maximum number of days in advance users can choose as the start date. This can be set only when `allow_start_in_future` is true.",
+			},
 			"payment_allow_renew_days": schema.Int32Attribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Int32{
@@ -143,7 +349,7 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Whether to allow promo codes to be applied",
 			},
 			"payment_billing_plan_description": schema.StringAttribute{
-				// payment_billing_plan_description is computed from paymant_billing_plan expression
+				// payment_billing_plan_description is computed from paymant_billing_plan expression and kept from the state by planBillingPlanDescription
 				Computed:            true,
 				MarkdownDescription: "The description of the term billing plan",
 			},
@@ -167,13 +373,61 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The first price of the term",
 			},
 			// https://docs.piano.io/api?endpoint=post~2F~2Fpublisher~2Fterm~2Fchange~2Foption~2Fcreate
-			// change_options should be defined separately after term creation
+			// change options are created by separate requests after the term is created
+			"change_options": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The options to change from this term to other terms. They are created after the term, so `piano_term_change_option` resources with `depends_on` are not needed. " +
+					"piano.io API can neither update nor delete a change option, so a change option removed or modified here is only removed from terraform state and remains in the term until it is deleted in piano.io dashboard or the term is deleted. " +
+					"Existing change options are not imported. Use `piano_term_change_option` to manage change options from terms managed elsewhere.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"term_change_option_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The term change option ID",
+						},
+						"to_term_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The term ID to change to",
+						},
+						"billing_timing": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("0", "1", "2", "3"),
+							},
+							MarkdownDescription: "The billing timing",
+						},
+						"immediate_access": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the access begins immediately",
+						},
+						"prorate_access": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the <a href=\"https://docs.piano.io/upgrades/?paragraphId=b27954ef84407e4#prorate-billing-amount\">Prorate billing amount</a> function is enabled. This value can be enabled only when the term is a subscription.",
+						},
+					},
+				},
+			},
 			"payment_has_free_trial": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Whether payment includes a free trial",
 			},
+			"payment_trial_period": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The period of the free trial such as `7 days` or `1 month`. " +
+					"The trial is sent to piano.io as the first billing cycle of `payment_billing_plan`, so `payment_billing_plan` should not include it. " +
+					"This can be set only when `payment_has_free_trial` is true.",
+			},
+			"payment_trial_price": schema.Float64Attribute{
+				Optional: true,
+				MarkdownDescription: "The price of the free trial in the currency of `payment_billing_plan`(default: 0). " +
+					"This can be set only when `payment_trial_period` is set.",
+			},
 			"schedule_billing": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The schedule billing",
@@ -184,7 +438,25 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Whether to collect an address for this term",
 			},
+			// PostPublisherTermPaymentCreateRequest and PostPublisherTermPaymentUpdateRequest have no collect_shipping_address.
+			// Only the gift term requests (PostPublisherTermGiftCreateRequest and PostPublisherTermGiftUpdateRequest) accept it.
+			"collect_shipping_address": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Whether to collect a shipping address for this gift term. " +
+					"piano.io API accepts `collect_shipping_address` only on the gift term endpoints, not on `/publisher/term/payment/create` or `/publisher/term/payment/update`, so this attribute is read only. " +
+					"Use `collect_address` to collect the address that `delivery_zone` applies to.",
+			},
+			"delivery_zone": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The delivery zone IDs of the term. This value can be set only when `collect_address` is true, as the payment term endpoints take no `collect_shipping_address`.",
+			},
 
+			"create_date_iso": unixTimeIsoAttribute("The creation date"),
+			"update_date_iso": unixTimeIsoAttribute("The update date"),
 			"update_date": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The update date",
@@ -195,6 +467,131 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				MarkdownDescription: "Whether to show the term only to users having no dynamic or purchase conversions yet",
 			},
+			"disabled": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Whether the term is disabled. piano.io publisher API provides no endpoint to enable or disable a term, so this attribute is read only. Use piano.io dashboard to pause the sale of the term.",
+			},
+			// PostPublisherTermPaymentCreateRequest and PostPublisherTermPaymentUpdateRequest have neither show_full_billing_plan nor billing_configuration.
+			// show_full_billing_plan is accepted only by PostPublisherTermDynamicUpdateFormdataBody (/publisher/term/dynamic/update), and no request accepts billing_configuration,
+			// so both attributes only reflect the term read from piano.io API.
+			"show_full_billing_plan": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Show full billing plan on checkout. piano.io publisher API accepts this value only for dynamic terms, so this attribute is read only.",
+			},
+			"billing_configuration": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "A JSON value representing a list of the access periods with billing configurations. " +
+					"piano.io publisher API does not accept this value for payment terms, so this attribute is read only. Use `payment_billing_plan` to configure billing.",
+			},
+			"payment_billing_plan_table": schema.ListNestedAttribute{
+				// payment_billing_plan_table is not kept from the state as it changes when payment_billing_plan or the schedule changes.
+				Computed:            true,
+				MarkdownDescription: "The billing plan resolved from `payment_billing_plan`. Use it to verify the effective price and period of each billing cycle.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"billing": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Payment condition such as \"one payment of $99.99\" or \"$119.99 per year\"",
+						},
+						"billing_info": schema.StringAttribute{
+							Computed: true,
+						},
+						"billing_period": schema.StringAttribute{
+							Computed: true,
+						},
+						"currency": schema.StringAttribute{
+							Computed: true,
+						},
+						"cycles": schema.StringAttribute{
+							Computed: true,
+						},
+						"date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Payment billing plan table date for humans such as \"Today\" or \"Apr 17, 2026\"",
+						},
+						"date_value": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Payment billing plan table date in timestamp",
+						},
+						"duration": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_free": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_free_trial": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_pay_what_you_want": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_trial": schema.StringAttribute{
+							Computed: true,
+						},
+						"period": schema.StringAttribute{
+							Computed: true,
+						},
+						"price": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Price with currency unit symbol",
+						},
+						"price_and_tax": schema.Float64Attribute{
+							Computed: true,
+						},
+						"price_and_tax_in_minor_unit": schema.Float32Attribute{
+							Computed: true,
+						},
+						"price_charged_str": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Price charged with currency unit symbol",
+						},
+						"price_value": schema.Float64Attribute{
+							Computed: true,
+						},
+						"short_period": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human readable billing period in shorter expression such as /yr",
+						},
+						"total_billing": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vouchering_policy": schema.SingleNestedAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "The vouchering policy of the term. piano.io accepts vouchering policies only on gift term endpoints, so this attribute is read only.",
+				Attributes: map[string]schema.Attribute{
+					"vouchering_policy_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The vouchering policy ID",
+					},
+					"vouchering_policy_billing_plan": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The billing plan of the vouchering policy",
+					},
+					"vouchering_policy_billing_plan_description": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The description of the vouchering policy billing plan",
+					},
+					"vouchering_policy_redemption_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The vouchering policy redemption URL",
+					},
+				},
+			},
 			"verify_on_renewal": schema.BoolAttribute{
 				Optional:            true,
 				Default:             booldefault.StaticBool(false),
@@ -293,10 +690,14 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The shared subscription redemption URL",
 			},
 			"currency_symbol": schema.StringAttribute{
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString("$"),
-				MarkdownDescription: "The currency symbol",
+				// piano.io API does not accept a currency symbol, so it is planned by planCurrencySymbol and kept from the state by Read.
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The currency symbol. Defaults to the symbol of `payment_currency`, or `payment_currency` itself when the symbol is not known. " +
+					"piano.io API does not accept a currency symbol, so this value is kept in terraform state and read from piano.io API only on import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"product_category": schema.StringAttribute{
 				Optional:            true,
@@ -318,14 +719,246 @@ func (*PaymentTermV2Resource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:             int32default.StaticInt32(15),
 				MarkdownDescription: "The number of days after expiration to still allow access to the resource",
 			},
+			"timeouts": timeoutsAttribute(),
 		},
 	}
 }
 
+func (r *PaymentTermV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PaymentTermV2ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateDeliveryZone(config, &resp.Diagnostics)
+	validateFreeTrial(config, &resp.Diagnostics)
+	validateStartInFuture(config, &resp.Diagnostics)
+}
+
+// validateDeliveryZone checks that delivery_zone is set only when collect_address is true.
+// The payment term endpoints take collect_address and delivery_zone but no collect_shipping_address,
+// so delivery zones apply to the address collected with collect_address.
+func validateDeliveryZone(config PaymentTermV2ResourceModel, diagnostics *diag.Diagnostics) {
+	if config.DeliveryZone.IsNull() || config.DeliveryZone.IsUnknown() || len(config.DeliveryZone.Elements()) == 0 || config.CollectAddress.IsUnknown() {
+		return
+	}
+	if !config.CollectAddress.ValueBool() {
+		diagnostics.AddAttributeError(
+			path.Root("delivery_zone"),
+			"Delivery Zone Without Address Collection",
+			"delivery_zone can be set only when collect_address is true. "+
+				"piano.io payment term API does not accept collect_shipping_address, so delivery zones apply to the address collected with collect_address.",
+		)
+	}
+}
+
+// validateFreeTrial checks that the free trial attributes are set only for a term with a free trial.
+func validateFreeTrial(config PaymentTermV2ResourceModel, diagnostics *diag.Diagnostics) {
+	if !config.PaymentTrialPrice.IsNull() && config.PaymentTrialPeriod.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("payment_trial_price"),
+			"Invalid Attribute Combination",
+			"payment_trial_price can be set only when payment_trial_period is set.",
+		)
+	}
+	if config.PaymentHasFreeTrial.IsUnknown() || config.PaymentHasFreeTrial.ValueBool() {
+		return
+	}
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{name: "payment_trial_period", value: config.PaymentTrialPeriod},
+		{name: "payment_trial_price", value: config.PaymentTrialPrice},
+	} {
+		if !attribute.value.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s can be set only when payment_has_free_trial is true.", attribute.name),
+			)
+		}
+	}
+}
+
+// validateStartInFuture checks that maximum_days_in_advance is set only for a term that can start in the future.
+func validateStartInFuture(config PaymentTermV2ResourceModel, diagnostics *diag.Diagnostics) {
+	if config.MaximumDaysInAdvance.IsNull() || config.AllowStartInFuture.IsUnknown() {
+		return
+	}
+	if !config.AllowStartInFuture.ValueBool() {
+		diagnostics.AddAttributeError(
+			path.Root("maximum_days_in_advance"),
+			"Invalid Attribute Combination",
+			"maximum_days_in_advance can be set only when allow_start_in_future is true.",
+		)
+	}
+}
+
+// paymentBillingPlanWithTrial prepends the free trial to the billing plan as its first billing cycle.
+// The trial is charged in the currency of the first billing cycle of the billing plan.
+func paymentBillingPlanWithTrial(plan PaymentTermV2ResourceModel, diagnostics *diag.Diagnostics) *string {
+	if plan.PaymentTrialPeriod.IsNull() || plan.PaymentTrialPeriod.IsUnknown() {
+		return plan.PaymentBillingPlan.ValueStringPointer()
+	}
+	billingPlan := plan.PaymentBillingPlan.ValueString()
+	cycle, ok := parseBillingCycle(billingPlan)
+	if !ok {
+		diagnostics.AddAttributeError(
+			path.Root("payment_billing_plan"),
+			"Invalid Billing Plan",
+			fmt.Sprintf("Unable to find the currency of the free trial in payment_billing_plan %s.", billingPlan),
+		)
+		return nil
+	}
+	price := strconv.FormatFloat(plan.PaymentTrialPrice.ValueFloat64(), 'f', -1, 64)
+	ret := fmt.Sprintf("[%s %s|%s|1]%s", price, cycle.currency, plan.PaymentTrialPeriod.ValueString(), billingPlan)
+	return &ret
+}
+
+// reconcileFreeTrial splits the free trial prepended by paymentBillingPlanWithTrial from the billing plan returned from piano.io API.
+// The billing plan is kept as is when the state does not manage the free trial.
+//
+// piano.io API may normalize the billing plan, e.g. "0" into "0.00" or "1 month" into "1 months",
+// so the billing cycles are compared structurally and the values in state are kept while they are equivalent.
+func reconcileFreeTrial(state *PaymentTermV2ResourceModel, billingPlan string) {
+	if state.PaymentTrialPeriod.IsNull() {
+		state.PaymentBillingPlan = reconcileBillingPlan(state.PaymentBillingPlan, billingPlan)
+		return
+	}
+	end := strings.Index(billingPlan, "]")
+	cycles, ok := parseBillingPlan(billingPlan)
+	if !ok || len(cycles) < 2 || cycles[0].interval != "1" {
+		state.PaymentTrialPeriod = types.StringNull()
+		state.PaymentTrialPrice = types.Float64Null()
+		state.PaymentBillingPlan = reconcileBillingPlan(state.PaymentBillingPlan, billingPlan)
+		return
+	}
+	trial := cycles[0]
+	if !sameBillingPeriod(state.PaymentTrialPeriod.ValueString(), trial.period) {
+		state.PaymentTrialPeriod = types.StringValue(trial.period)
+	}
+	if !state.PaymentTrialPrice.IsNull() || trial.price != 0 {
+		state.PaymentTrialPrice = types.Float64Value(trial.price)
+	}
+	state.PaymentBillingPlan = reconcileBillingPlan(state.PaymentBillingPlan, billingPlan[end+1:])
+}
+
+// reconcileBillingPlan keeps the billing plan in state when it is equivalent to the one returned from piano.io API.
+func reconcileBillingPlan(state types.String, billingPlan string) types.String {
+	if state.IsNull() || state.IsUnknown() {
+		return types.StringValue(billingPlan)
+	}
+	expected, ok := parseBillingPlan(state.ValueString())
+	if !ok {
+		return types.StringValue(billingPlan)
+	}
+	actual, ok := parseBillingPlan(billingPlan)
+	if !ok || len(actual) != len(expected) {
+		return types.StringValue(billingPlan)
+	}
+	for i := range actual {
+		if !actual[i].equivalent(expected[i]) {
+			return types.StringValue(billingPlan)
+		}
+	}
+	return state
+}
+
+// billingCycle is a billing cycle of payment billing plan expression such as [19.99 USD|1 month|*].
+type billingCycle struct {
+	price    float64
+	currency string
+	period   string
+	interval string
+}
+
+// equivalent reports whether both billing cycles charge the same price in the same period regardless of their notation.
+func (c billingCycle) equivalent(other billingCycle) bool {
+	return c.price == other.price &&
+		strings.EqualFold(c.currency, other.currency) &&
+		sameBillingPeriod(c.period, other.period) &&
+		c.interval == other.interval
+}
+
+// sameBillingPeriod reports whether both periods such as "1 month" and "1 months" are the same.
+func sameBillingPeriod(a string, b string) bool {
+	normalize := func(period string) string {
+		fields := strings.Fields(strings.ToLower(period))
+		if len(fields) != 2 {
+			return strings.Join(fields, " ")
+		}
+		return fields[0] + " " + strings.TrimSuffix(fields[1], "s")
+	}
+	return normalize(a) == normalize(b)
+}
+
+// parseBillingPlan parses all the billing cycles of payment billing plan expression.
+func parseBillingPlan(billingPlan string) ([]billingCycle, bool) {
+	cycles := []billingCycle{}
+	for billingPlan != "" {
+		cycle, ok := parseBillingCycle(billingPlan)
+		if !ok {
+			return nil, false
+		}
+		cycles = append(cycles, cycle)
+		billingPlan = billingPlan[strings.Index(billingPlan, "]")+1:]
+	}
+	return cycles, len(cycles) > 0
+}
+
+// parseBillingCycle parses the first billing cycle of payment billing plan expression.
+func parseBillingCycle(billingPlan string) (billingCycle, bool) {
+	end := strings.Index(billingPlan, "]")
+	if !strings.HasPrefix(billingPlan, "[") || end < 0 {
+		return billingCycle{}, false
+	}
+	parts := strings.Split(billingPlan[1:end], "|")
+	if len(parts) != 3 {
+		return billingCycle{}, false
+	}
+	amount := strings.Fields(parts[0])
+	if len(amount) != 2 {
+		return billingCycle{}, false
+	}
+	price, err := strconv.ParseFloat(amount[0], 64)
+	if err != nil {
+		return billingCycle{}, false
+	}
+	return billingCycle{
+		price:    price,
+		currency: amount[1],
+		period:   strings.TrimSpace(parts[1]),
+		interval: strings.TrimSpace(parts[2]),
+	}, true
+}
+
+// deliveryZoneStringFrom converts delivery zone IDs into the comma-separated form piano.io API accepts.
+func deliveryZoneStringFrom(ctx context.Context, deliveryZone types.Set, diagnostics *diag.Diagnostics) string {
+	ids := []string{}
+	if deliveryZone.IsNull() || deliveryZone.IsUnknown() {
+		return ""
+	}
+	diagnostics.Append(deliveryZone.ElementsAs(ctx, &ids, false)...)
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
+
 func (r *PaymentTermV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan PaymentTermV2ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, timeoutCreate)
+	defer cancel()
+	var deliveryZone *string
+	if !plan.DeliveryZone.IsNull() {
+		value := deliveryZoneStringFrom(ctx, plan.DeliveryZone, &resp.Diagnostics)
+		deliveryZone = &value
+	}
+	paymentBillingPlan := paymentBillingPlanWithTrial(plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -334,7 +967,8 @@ func (r *PaymentTermV2Resource) Create(ctx context.Context, req resource.CreateR
 		Rid:                          plan.Rid.ValueString(),
 		Name:                         plan.Name.ValueString(),
 		Description:                  plan.Description.ValueStringPointer(),
-		PaymentBillingPlan:           plan.PaymentBillingPlan.ValueStringPointer(),
+		ProductCategory:              plan.ProductCategory.ValueStringPointer(),
+		PaymentBillingPlan:           paymentBillingPlan,
 		PaymentAllowRenewDays:        plan.PaymentAllowRenewDays.ValueInt32Pointer(),
 		PaymentForceAutoRenew:        plan.PaymentForceAutoRenew.ValueBoolPointer(),
 		PaymentNewCustomersOnly:      plan.PaymentNewCustomersOnly.ValueBoolPointer(),
@@ -345,7 +979,10 @@ func (r *PaymentTermV2Resource) Create(ctx context.Context, req resource.CreateR
 		SharedAccountCount:           plan.SharedAccountCount.ValueInt32Pointer(),
 		SharedRedemptionUrl:          plan.SharedRedemptionUrl.ValueStringPointer(),
 		CollectAddress:               plan.CollectAddress.ValueBoolPointer(),
+		DeliveryZone:                 deliveryZone,
 		VerifyOnRenewal:              plan.VerifyOnRenewal.ValueBoolPointer(),
+		AllowStartInFuture:           plan.AllowStartInFuture.ValueBoolPointer(),
+		MaximumDaysInAdvance:         plan.MaximumDaysInAdvance.ValueInt32Pointer(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resource, got error: %s", err))
@@ -363,40 +1000,80 @@ func (r *PaymentTermV2Resource) Create(ctx context.Context, req resource.CreateR
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, &resp.Diagnostics) {
+		return
+	}
 	plan.TermId = types.StringValue(result.Term.TermId)
 	plan.CreateDate = types.Int64Value(int64(result.Term.CreateDate))
 	plan.UpdateDate = types.Int64Value(int64(result.Term.UpdateDate))
+	plan.CreateDateIso = unixTimeIsoFrom(plan.CreateDate, r.dateLocation)
+	plan.UpdateDateIso = unixTimeIsoFrom(plan.UpdateDate, r.dateLocation)
 	plan.Type = types.StringValue(string(result.Term.Type))
 	plan.PaymentBillingPlanDescription = types.StringValue(result.Term.PaymentBillingPlanDescription)
 	plan.PaymentFirstPrice = types.Float64Value(result.Term.PaymentFirstPrice)
+	if plan.CurrencySymbol.IsUnknown() {
+		plan.CurrencySymbol = currencySymbolFromTerm(result.Term)
+	}
+	plan.CollectShippingAddress = types.BoolPointerValue(result.Term.CollectShippingAddress)
+	plan.Disabled = types.BoolPointerValue(result.Term.Disabled)
+	paymentBillingPlanTable, diags := PaymentBillingPlanTableListFrom(ctx, result.Term.PaymentBillingPlanTable)
+	resp.Diagnostics.Append(diags...)
+	plan.PaymentBillingPlanTable = paymentBillingPlanTable
+	plan.ShowFullBillingPlan = types.BoolPointerValue(result.Term.ShowFullBillingPlan)
+	plan.BillingConfiguration = types.StringPointerValue(result.Term.BillingConfiguration)
+	voucheringPolicy, diags := VoucheringPolicyObjectFrom(ctx, result.Term.VoucheringPolicy)
+	resp.Diagnostics.Append(diags...)
+	plan.VoucheringPolicy = voucheringPolicy
+	// the term is saved with the change options created so far even if creating the rest fails
+	plan.ChangeOptions = r.createChangeOptions(ctx, result.Term, plan.ChangeOptions, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
 }
 func (r *PaymentTermV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan PaymentTermV2ResourceModel
+	var state PaymentTermV2ResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("%v", resp.Diagnostics))
 		return
 	}
-	response, err := r.client.PostPublisherTermPaymentUpdateWithFormdataBody(ctx, piano_publisher.PostPublisherTermPaymentUpdateRequest{
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, timeoutUpdate)
+	defer cancel()
+	// delivery zones are cleared by sending an empty list when the attribute is removed
+	deliveryZone := deliveryZoneStringFrom(ctx, plan.DeliveryZone, &resp.Diagnostics)
+	paymentBillingPlan := paymentBillingPlanWithTrial(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// only changed attributes are sent so that fields changed outside terraform are kept as they are
+	request := piano_publisher.PostPublisherTermPaymentUpdateRequest{
 		TermId:                       plan.TermId.ValueString(),
-		Description:                  plan.Description.ValueStringPointer(),
-		PaymentBillingPlan:           plan.PaymentBillingPlan.ValueStringPointer(),
-		PaymentAllowRenewDays:        plan.PaymentAllowRenewDays.ValueInt32Pointer(),
-		PaymentForceAutoRenew:        plan.PaymentForceAutoRenew.ValueBoolPointer(),
-		PaymentNewCustomersOnly:      plan.PaymentNewCustomersOnly.ValueBoolPointer(),
-		PaymentTrialNewCustomersOnly: plan.PaymentTrialNewCustomersOnly.ValueBoolPointer(),
-		PaymentAllowPromoCodes:       plan.PaymentAllowPromoCodes.ValueBoolPointer(),
-		PaymentRenewGracePeriod:      plan.PaymentRenewGracePeriod.ValueInt32Pointer(),
-		PaymentAllowGift:             plan.PaymentAllowGift.ValueBoolPointer(),
-		SharedAccountCount:           plan.SharedAccountCount.ValueInt32Pointer(),
-		SharedRedemptionUrl:          plan.SharedRedemptionUrl.ValueStringPointer(),
-		CollectAddress:               plan.CollectAddress.ValueBoolPointer(),
-		VerifyOnRenewal:              plan.VerifyOnRenewal.ValueBoolPointer(),
-	})
+		Description:                  syntax.ChangedString(plan.Description, state.Description),
+		ProductCategory:              syntax.ChangedString(plan.ProductCategory, state.ProductCategory),
+		PaymentAllowRenewDays:        syntax.ChangedInt32(plan.PaymentAllowRenewDays, state.PaymentAllowRenewDays),
+		PaymentForceAutoRenew:        syntax.ChangedBool(plan.PaymentForceAutoRenew, state.PaymentForceAutoRenew),
+		PaymentNewCustomersOnly:      syntax.ChangedBool(plan.PaymentNewCustomersOnly, state.PaymentNewCustomersOnly),
+		PaymentTrialNewCustomersOnly: syntax.ChangedBool(plan.PaymentTrialNewCustomersOnly, state.PaymentTrialNewCustomersOnly),
+		PaymentAllowPromoCodes:       syntax.ChangedBool(plan.PaymentAllowPromoCodes, state.PaymentAllowPromoCodes),
+		PaymentRenewGracePeriod:      syntax.ChangedInt32(plan.PaymentRenewGracePeriod, state.PaymentRenewGracePeriod),
+		PaymentAllowGift:             syntax.ChangedBool(plan.PaymentAllowGift, state.PaymentAllowGift),
+		SharedAccountCount:           syntax.ChangedInt32(plan.SharedAccountCount, state.SharedAccountCount),
+		SharedRedemptionUrl:          syntax.ChangedString(plan.SharedRedemptionUrl, state.SharedRedemptionUrl),
+		CollectAddress:               syntax.ChangedBool(plan.CollectAddress, state.CollectAddress),
+		VerifyOnRenewal:              syntax.ChangedBool(plan.VerifyOnRenewal, state.VerifyOnRenewal),
+		AllowStartInFuture:           syntax.ChangedBool(plan.AllowStartInFuture, state.AllowStartInFuture),
+		MaximumDaysInAdvance:         syntax.ChangedInt32(plan.MaximumDaysInAdvance, state.MaximumDaysInAdvance),
+	}
+	if !plan.PaymentBillingPlan.Equal(state.PaymentBillingPlan) || !plan.PaymentTrialPeriod.Equal(state.PaymentTrialPeriod) || !plan.PaymentTrialPrice.Equal(state.PaymentTrialPrice) {
+		request.PaymentBillingPlan = paymentBillingPlan
+	}
+	if !plan.DeliveryZone.Equal(state.DeliveryZone) {
+		request.DeliveryZone = &deliveryZone
+	}
+	response, err := r.client.PostPublisherTermPaymentUpdateWithFormdataBody(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update resource, got error: %s", err))
 		return
@@ -415,11 +1092,131 @@ func (r *PaymentTermV2Resource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	plan.UpdateDate = types.Int64Value(int64(result.Term.UpdateDate))
-	plan.PaymentBillingPlanDescription = types.StringValue(result.Term.PaymentBillingPlanDescription)
-	plan.PaymentFirstPrice = types.Float64Value(result.Term.PaymentFirstPrice)
+	plan.CreateDateIso = unixTimeIsoFrom(plan.CreateDate, r.dateLocation)
+	plan.UpdateDateIso = unixTimeIsoFrom(plan.UpdateDate, r.dateLocation)
+	// payment_billing_plan_description and payment_first_price are planned from the state while the billing plan is unchanged
+	if plan.PaymentBillingPlanDescription.IsUnknown() {
+		plan.PaymentBillingPlanDescription = types.StringValue(result.Term.PaymentBillingPlanDescription)
+	}
+	if plan.PaymentFirstPrice.IsUnknown() {
+		plan.PaymentFirstPrice = types.Float64Value(result.Term.PaymentFirstPrice)
+	}
+	if plan.CurrencySymbol.IsUnknown() {
+		plan.CurrencySymbol = currencySymbolFromTerm(result.Term)
+	}
+	plan.CollectShippingAddress = types.BoolPointerValue(result.Term.CollectShippingAddress)
+	plan.Disabled = types.BoolPointerValue(result.Term.Disabled)
+	paymentBillingPlanTable, diags := PaymentBillingPlanTableListFrom(ctx, result.Term.PaymentBillingPlanTable)
+	resp.Diagnostics.Append(diags...)
+	plan.PaymentBillingPlanTable = paymentBillingPlanTable
+	plan.ShowFullBillingPlan = types.BoolPointerValue(result.Term.ShowFullBillingPlan)
+	plan.BillingConfiguration = types.StringPointerValue(result.Term.BillingConfiguration)
+	voucheringPolicy, diags := VoucheringPolicyObjectFrom(ctx, result.Term.VoucheringPolicy)
+	resp.Diagnostics.Append(diags...)
+	plan.VoucheringPolicy = voucheringPolicy
+	warnAbandonedChangeOptions(plan.TermId.ValueString(), plan.ChangeOptions, state.ChangeOptions, &resp.Diagnostics)
+	plan.ChangeOptions = r.createChangeOptions(ctx, result.Term, plan.ChangeOptions, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// createChangeOptions creates the change options that have no ID yet from the term.
+// It returns the change options with their IDs. Change options that fail to be created are left out.
+func (r *PaymentTermV2Resource) createChangeOptions(ctx context.Context, term piano_publisher.Term, options []PaymentTermChangeOptionModel, diagnostics *diag.Diagnostics) []PaymentTermChangeOptionModel {
+	if options == nil {
+		return nil
+	}
+	ret := []PaymentTermChangeOptionModel{}
+	for i, option := range options {
+		if !option.TermChangeOptionId.IsUnknown() {
+			ret = append(ret, option)
+			continue
+		}
+		if diagnostics.HasError() {
+			continue
+		}
+		if option.ProrateAccess.ValueBool() && !isSubscriptionTerm(term.Type, term.PaymentIsSubscription) {
+			diagnostics.AddAttributeError(
+				path.Root("change_options").AtListIndex(i).AtName("prorate_access"),
+				"Invalid Prorate Access",
+				fmt.Sprintf("prorate_access can be enabled only when the term to change from is a subscription, but Term %s is not.", term.TermId),
+			)
+			continue
+		}
+		var created *piano_publisher.TermChangeOption
+		// the term may have been created in the same apply and not be visible yet.
+		found := retryUntilFound(ctx, r.maxRetries, func() bool {
+			var found bool
+			created, found = r.createChangeOption(ctx, term, option, diagnostics)
+			return found || diagnostics.HasError()
+		})
+		if diagnostics.HasError() {
+			continue
+		}
+		if !found {
+			diagnostics.AddAttributeError(path.Root("change_options").AtListIndex(i), "Term Not Found", fmt.Sprintf("Term %s not found", term.TermId))
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("created Term Change Option:%s from %s to %s", created.TermChangeOptionId, created.FromTermId, created.ToTermId))
+		option.TermChangeOptionId = types.StringValue(created.TermChangeOptionId)
+		ret = append(ret, option)
+	}
+	return ret
+}
+
+// createChangeOption creates a change option from the term. It reports false when the term is not found.
+func (r *PaymentTermV2Resource) createChangeOption(ctx context.Context, term piano_publisher.Term, option PaymentTermChangeOptionModel, diagnostics *diag.Diagnostics) (*piano_publisher.TermChangeOption, bool) {
+	response, err := r.client.PostPublisherTermChangeOptionCreateWithFormdataBody(ctx, piano_publisher.PostPublisherTermChangeOptionCreateFormdataRequestBody{
+		Aid:             term.Aid,
+		FromTermId:      term.TermId,
+		ToTermId:        option.ToTermId.ValueString(),
+		BillingTiming:   piano_publisher.PostPublisherTermChangeOptionCreateRequestBillingTiming(option.BillingTiming.ValueString()),
+		ImmediateAccess: option.ImmediateAccess.ValueBool(),
+		ProrateAccess:   option.ProrateAccess.ValueBool(),
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create term change option, got error: %s", err))
+		return nil, false
+	}
+	anyResponse, err := syntax.AnyResponseFrom(response, diagnostics)
+	if err != nil {
+		return nil, false
+	}
+	if errors.Is(anyResponse.Err(), syntax.ErrNotFound) {
+		return nil, false
+	}
+	if anyResponse.Code != 0 {
+		syntax.AddStatusError(anyResponse, diagnostics)
+		return nil, false
+	}
+	result := piano_publisher.TermChangeOptionResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return nil, false
+	}
+	return &result.TermChangeOption, true
+}
+
+// warnAbandonedChangeOptions warns about the change options removed from change_options.
+// piano.io API does not provide an endpoint to delete a term change option, so they remain in the term.
+func warnAbandonedChangeOptions(termId string, plan []PaymentTermChangeOptionModel, state []PaymentTermChangeOptionModel, diagnostics *diag.Diagnostics) {
+	for _, option := range state {
+		kept := false
+		for _, planned := range plan {
+			if planned.TermChangeOptionId.Equal(option.TermChangeOptionId) {
+				kept = true
+				break
+			}
+		}
+		if !kept {
+			diagnostics.AddWarning(
+				"Term Change Option Not Deleted",
+				fmt.Sprintf("piano.io API does not support deleting Term Change Option %s. It is removed from terraform state, but remains in Term %s until it is deleted in piano.io dashboard or the term is deleted.", option.TermChangeOptionId.ValueString(), termId),
+			)
+		}
+	}
+}
+
 func (r *PaymentTermV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state PaymentTermV2ResourceModel
 
@@ -429,6 +1226,8 @@ func (r *PaymentTermV2Resource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutRead)
+	defer cancel()
 
 	response, err := r.client.GetPublisherTermGet(ctx, &piano_publisher.GetPublisherTermGetParams{
 		TermId: state.TermId.ValueString(),
@@ -448,18 +1247,21 @@ func (r *PaymentTermV2Resource) Read(ctx context.Context, req resource.ReadReque
 		resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
 		return
 	}
+	if !syntax.RequireIdentifier(anyResponse, "term_id", result.Term.TermId, &resp.Diagnostics) {
+		return
+	}
 
 	data := result.Term
 
 	state.PaymentRenewGracePeriod = types.Int32Value(data.PaymentRenewGracePeriod)
 
 	state.Type = types.StringValue(string(data.Type))
-	if data.ProductCategory != "" {
-		state.ProductCategory = types.StringValue(data.ProductCategory)
+	state.ProductCategory = syntax.ReconcileOptionalString(state.ProductCategory, &data.ProductCategory)
+	if state.CurrencySymbol.IsNull() {
+		state.CurrencySymbol = currencySymbolFromTerm(data)
 	}
-	state.CurrencySymbol = types.StringValue(data.CurrencySymbol)
-	state.SharedAccountCount = types.Int32PointerValue(data.SharedAccountCount)
-	state.SharedRedemptionUrl = types.StringPointerValue(data.SharedRedemptionUrl)
+	state.SharedAccountCount = syntax.ReconcileOptionalInt32(state.SharedAccountCount, data.SharedAccountCount)
+	state.SharedRedemptionUrl = syntax.ReconcileOptionalString(state.SharedRedemptionUrl, data.SharedRedemptionUrl)
 	state.PaymentCurrency = types.StringValue(data.PaymentCurrency)
 	state.PaymentTrialNewCustomersOnly = types.BoolValue(data.PaymentTrialNewCustomersOnly)
 	if data.Schedule != nil {
@@ -468,7 +1270,7 @@ func (r *PaymentTermV2Resource) Read(ctx context.Context, req resource.ReadReque
 	}
 	state.PaymentForceAutoRenew = types.BoolValue(data.PaymentForceAutoRenew)
 	state.PaymentAllowGift = types.BoolValue(data.PaymentAllowGift)
-	state.PaymentBillingPlan = types.StringValue(data.PaymentBillingPlan)
+	reconcileFreeTrial(&state, data.PaymentBillingPlan)
 
 	Resource := ResourceResourceModelFrom(data.Resource)
 	state.Rid = Resource.Rid
@@ -477,9 +1279,26 @@ func (r *PaymentTermV2Resource) Read(ctx context.Context, req resource.ReadReque
 	state.VerifyOnRenewal = types.BoolValue(data.VerifyOnRenewal)
 	state.PaymentNewCustomersOnly = types.BoolValue(data.PaymentNewCustomersOnly)
 	state.UpdateDate = types.Int64Value(int64(data.UpdateDate))
+	state.CreateDateIso = unixTimeIsoFrom(state.CreateDate, r.dateLocation)
+	state.UpdateDateIso = unixTimeIsoFrom(state.UpdateDate, r.dateLocation)
 	state.CollectAddress = types.BoolValue(data.CollectAddress)
+	state.CollectShippingAddress = types.BoolPointerValue(data.CollectShippingAddress)
+	deliveryZoneIds := DeliveryZoneIdsFrom(data.DeliveryZone)
+	if !state.DeliveryZone.IsNull() || len(deliveryZoneIds) > 0 {
+		deliveryZone, diags := types.SetValueFrom(ctx, types.StringType, deliveryZoneIds)
+		resp.Diagnostics.Append(diags...)
+		state.DeliveryZone = deliveryZone
+	}
 	state.ScheduleBilling = types.StringPointerValue(data.ScheduleBilling)
+	state.AllowStartInFuture = types.BoolValue(data.AllowStartInFuture != nil && *data.AllowStartInFuture)
+	// piano.io API returns 0 for terms that have never set maximum_days_in_advance
+	if !state.MaximumDaysInAdvance.IsNull() || (data.MaximumDaysInAdvance != nil && *data.MaximumDaysInAdvance != 0) {
+		state.MaximumDaysInAdvance = types.Int32PointerValue(data.MaximumDaysInAdvance)
+	}
 	state.PaymentHasFreeTrial = types.BoolValue(data.PaymentHasFreeTrial)
+	if !AidMatches(state.Aid, data.Aid, &resp.Diagnostics) {
+		return
+	}
 	state.Aid = types.StringValue(data.Aid)
 
 	state.PaymentFirstPrice = types.Float64Value(data.PaymentFirstPrice)
@@ -493,6 +1312,16 @@ func (r *PaymentTermV2Resource) Read(ctx context.Context, req resource.ReadReque
 	state.PaymentAllowPromoCodes = types.BoolValue(data.PaymentAllowPromoCodes)
 	state.Description = types.StringValue(data.Description)
 	state.PaymentAllowRenewDays = types.Int32Value(data.PaymentAllowRenewDays)
+	state.Disabled = types.BoolPointerValue(data.Disabled)
+	paymentBillingPlanTable, diags := PaymentBillingPlanTableListFrom(ctx, data.PaymentBillingPlanTable)
+	resp.Diagnostics.Append(diags...)
+	state.PaymentBillingPlanTable = paymentBillingPlanTable
+	state.ShowFullBillingPlan = types.BoolPointerValue(data.ShowFullBillingPlan)
+	state.BillingConfiguration = types.StringPointerValue(data.BillingConfiguration)
+	voucheringPolicy, diags := VoucheringPolicyObjectFrom(ctx, data.VoucheringPolicy)
+	resp.Diagnostics.Append(diags...)
+	state.VoucheringPolicy = voucheringPolicy
+	state.ChangeOptions = reconcileChangeOptions(ctx, data, state.ChangeOptions)
 
 	tflog.Trace(ctx, "read a resource")
 
@@ -505,6 +1334,8 @@ func (r *PaymentTermV2Resource) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := withTimeout(ctx, state.Timeouts, timeoutDelete)
+	defer cancel()
 
 	tflog.Info(ctx, fmt.Sprintf("deleting Term %s:%s in $%s", state.Name.ValueString(), state.TermId.ValueString(), state.Aid.ValueString()))
 	response, err := r.client.PostPublisherTermDeleteWithFormdataBody(ctx, piano_publisher.PostPublisherTermDeleteFormdataRequestBody{
@@ -514,7 +1345,7 @@ func (r *PaymentTermV2Resource) Delete(ctx context.Context, req resource.DeleteR
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource, got error: %s", err))
 		return
 	}
-	_, err = syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+	err = syntax.DeletedResponseFrom(ctx, response, &resp.Diagnostics)
 	if err != nil {
 		return
 	}
@@ -528,3 +1359,107 @@ func (r *PaymentTermV2Resource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aid"), id.Aid)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("term_id"), id.TermId)...)
 }
+
+// reconcileChangeOptions refreshes the change options in the state from the term.
+// Change options deleted outside terraform are dropped so that they are created again.
+// Change options not managed in the state are ignored as they may be managed by piano_term_change_option.
+func reconcileChangeOptions(ctx context.Context, term piano_publisher.Term, options []PaymentTermChangeOptionModel) []PaymentTermChangeOptionModel {
+	if options == nil {
+		return nil
+	}
+	ret := []PaymentTermChangeOptionModel{}
+	for _, option := range options {
+		data := TermChangeOptionFrom(term, option.TermChangeOptionId.ValueString())
+		if data == nil {
+			tflog.Warn(ctx, fmt.Sprintf("Term Change Option %s not found in Term %s. Removing it from state", option.TermChangeOptionId.ValueString(), term.TermId))
+			continue
+		}
+		option.ToTermId = types.StringValue(data.ToTermId)
+		option.BillingTiming = types.StringValue(string(data.BillingTiming))
+		option.ImmediateAccess = types.BoolValue(data.ImmediateAccess)
+		option.ProrateAccess = types.BoolValue(data.ProrateAccess)
+		ret = append(ret, option)
+	}
+	return ret
+}
+
+// MoveState allows users to migrate piano_payment_term resources to piano_payment_term_v2 with a `moved` block.
+func (r *PaymentTermV2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	source := resource.SchemaResponse{}
+	(&PaymentTermResource{}).Schema(ctx, resource.SchemaRequest{}, &source)
+	return []resource.StateMover{
+		{
+			SourceSchema: &source.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "piano_payment_term" || req.SourceProviderAddress != "registry.terraform.io/i10416/piano" {
+					return
+				}
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError("Unable to Move Resource State", "piano_payment_term state is not available.")
+					return
+				}
+				var source PaymentTermResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := PaymentTermV2ResourceModelFromPaymentTerm(source)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
+
+// PaymentTermV2ResourceModelFromPaymentTerm converts piano_payment_term state into piano_payment_term_v2 state.
+// The nested resource is flattened into rid. Attributes missing in piano_payment_term are left null and refreshed on the next read.
+func PaymentTermV2ResourceModelFromPaymentTerm(data PaymentTermResourceModel) PaymentTermV2ResourceModel {
+	ret := PaymentTermV2ResourceModel{}
+	ret.Aid = data.Aid
+	if data.Resource != nil {
+		ret.Rid = data.Resource.Rid
+	} else {
+		ret.Rid = types.StringNull()
+	}
+	ret.CollectAddress = data.CollectAddress
+	ret.CollectShippingAddress = data.CollectShippingAddress
+	ret.CreateDate = data.CreateDate
+	ret.CurrencySymbol = data.CurrencySymbol
+	ret.DeliveryZone = data.DeliveryZone
+	ret.Description = data.Description
+	ret.EvtVerificationPeriod = data.EvtVerificationPeriod
+	ret.IsAllowedToChangeSchedulePeriodInPast = data.IsAllowedToChangeSchedulePeriodInPast
+	ret.Name = data.Name
+	ret.PaymentAllowGift = data.PaymentAllowGift
+	ret.PaymentAllowPromoCodes = data.PaymentAllowPromoCodes
+	ret.PaymentAllowRenewDays = data.PaymentAllowRenewDays
+	ret.PaymentBillingPlan = data.PaymentBillingPlan
+	ret.PaymentBillingPlanDescription = data.PaymentBillingPlanDescription
+	ret.PaymentCurrency = data.PaymentCurrency
+	ret.PaymentFirstPrice = data.PaymentFirstPrice
+	ret.PaymentForceAutoRenew = data.PaymentForceAutoRenew
+	ret.PaymentHasFreeTrial = data.PaymentHasFreeTrial
+	ret.PaymentIsCustomPriceAvailable = data.PaymentIsCustomPriceAvailable
+	ret.PaymentNewCustomersOnly = data.PaymentNewCustomersOnly
+	ret.PaymentRenewGracePeriod = data.PaymentRenewGracePeriod
+	ret.PaymentTrialNewCustomersOnly = data.PaymentTrialNewCustomersOnly
+	ret.PaymentTrialPeriod = types.StringNull()
+	ret.AllowStartInFuture = types.BoolNull()
+	ret.MaximumDaysInAdvance = types.Int32Null()
+	ret.PaymentTrialPrice = types.Float64Null()
+	ret.ProductCategory = syntax.ReconcileOptionalString(types.StringNull(), data.ProductCategory.ValueStringPointer())
+	ret.Schedule = data.Schedule
+	ret.ScheduleBilling = data.ScheduleBilling
+	ret.SharedAccountCount = data.SharedAccountCount
+	ret.SharedRedemptionUrl = data.SharedRedemptionUrl
+	ret.TermId = data.TermId
+	ret.Timeouts = timeoutsNull()
+	ret.Type = data.Type
+	ret.UpdateDate = data.UpdateDate
+	ret.VerifyOnRenewal = data.VerifyOnRenewal
+	ret.Disabled = types.BoolNull()
+	ret.PaymentBillingPlanTable = types.ListNull(PaymentBillingPlanTableAttrType())
+	ret.ShowFullBillingPlan = types.BoolNull()
+	ret.BillingConfiguration = types.StringNull()
+	ret.VoucheringPolicy = types.ObjectNull(VoucheringPolicyAttrTypes())
+	return ret
+}
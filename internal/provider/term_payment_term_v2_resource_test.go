@@ -0,0 +1,1133 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPaymentTermV2ResourceMoveStateFromPaymentTerm(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{}
+	movers := r.MoveState(ctx)
+	if len(movers) != 1 {
+		t.Fatalf("expected 1 state mover, got %d", len(movers))
+	}
+	mover := movers[0]
+
+	// piano_payment_term state as stored in terraform state file.
+	blob := []byte(`{
+		"aid": "example",
+		"term_id": "TMXXXXXXXXXX",
+		"name": "Monthly",
+		"description": "Monthly subscription",
+		"type": "payment",
+		"payment_billing_plan": "[19.99 USD|1 month|*]",
+		"payment_billing_plan_description": "$19.99 per month",
+		"payment_currency": "USD",
+		"currency_symbol": "$",
+		"payment_renew_grace_period": 15,
+		"payment_allow_gift": true,
+		"create_date": 1735657200,
+		"update_date": 1735657200,
+		"resource": {
+			"aid": "example",
+			"rid": "RXXXXXXX",
+			"name": "Premium"
+		}
+	}`)
+	sourceType := mover.SourceSchema.Type().TerraformType(ctx)
+	sourceValue, err := tftypes.ValueFromJSON(blob, sourceType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &target)
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/i10416/piano",
+		SourceTypeName:        "piano_payment_term",
+		SourceState:           &tfsdk.State{Schema: *mover.SourceSchema, Raw: sourceValue},
+	}
+	resp := resource.MoveStateResponse{
+		TargetState: newTestState(t, target.Schema, nil),
+	}
+	mover.StateMover(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var actual PaymentTermV2ResourceModel
+	if diags := resp.TargetState.Get(ctx, &actual); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if actual.Rid.ValueString() != "RXXXXXXX" {
+		t.Errorf("expected rid to be flattened from resource, got %s", actual.Rid)
+	}
+	if actual.TermId.ValueString() != "TMXXXXXXXXXX" || actual.Aid.ValueString() != "example" {
+		t.Errorf("expected term_id and aid to be kept, got %s %s", actual.TermId, actual.Aid)
+	}
+	if actual.PaymentBillingPlan.ValueString() != "[19.99 USD|1 month|*]" {
+		t.Errorf("expected payment_billing_plan to be kept, got %s", actual.PaymentBillingPlan)
+	}
+	if !actual.PaymentAllowGift.ValueBool() || actual.PaymentRenewGracePeriod.ValueInt32() != 15 {
+		t.Errorf("expected payment attributes to be kept, got %s %s", actual.PaymentAllowGift, actual.PaymentRenewGracePeriod)
+	}
+	if !actual.SharedAccountCount.IsNull() {
+		t.Errorf("expected shared_account_count to be null, got %s", actual.SharedAccountCount)
+	}
+	if !actual.Disabled.IsNull() {
+		t.Errorf("expected disabled to be left for refresh, got %s", actual.Disabled)
+	}
+	if !actual.ShowFullBillingPlan.IsNull() || !actual.BillingConfiguration.IsNull() {
+		t.Errorf("expected show_full_billing_plan and billing_configuration to be left for refresh, got %s and %s", actual.ShowFullBillingPlan, actual.BillingConfiguration)
+	}
+}
+
+func TestPaymentTermV2ResourceMoveStateWithoutResource(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{}
+	mover := r.MoveState(ctx)[0]
+
+	// piano_payment_term imported before the nested resource was refreshed has no resource block.
+	sourceValue, err := tftypes.ValueFromJSON([]byte(`{"aid": "example", "term_id": "TMXXXXXXXXXX", "name": "Monthly"}`), mover.SourceSchema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &target)
+	resp := resource.MoveStateResponse{
+		TargetState: newTestState(t, target.Schema, nil),
+	}
+	mover.StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/i10416/piano",
+		SourceTypeName:        "piano_payment_term",
+		SourceState:           &tfsdk.State{Schema: *mover.SourceSchema, Raw: sourceValue},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var actual PaymentTermV2ResourceModel
+	if diags := resp.TargetState.Get(ctx, &actual); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !actual.Rid.IsNull() {
+		t.Errorf("expected rid to be left for refresh, got %s", actual.Rid)
+	}
+	if actual.TermId.ValueString() != "TMXXXXXXXXXX" {
+		t.Errorf("expected term_id to be kept, got %s", actual.TermId)
+	}
+}
+
+func TestPaymentTermV2ResourceMoveStateIgnoresOtherResources(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{}
+	mover := r.MoveState(ctx)[0]
+
+	target := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &target)
+	resp := resource.MoveStateResponse{
+		TargetState: newTestState(t, target.Schema, nil),
+	}
+	mover.StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/i10416/piano",
+		SourceTypeName:        "piano_external_term",
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !resp.TargetState.Raw.IsNull() {
+		t.Errorf("expected target state to be untouched, got %s", resp.TargetState.Raw)
+	}
+}
+
+func TestPaymentTermV2ResourceModifyPlanTermType(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{defaultAid: types.StringNull()}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	newValue := func(termType tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["aid"] = tftypes.NewValue(tftypes.String, "AID")
+		values["name"] = tftypes.NewValue(tftypes.String, "example")
+		values["type"] = termType
+		return tftypes.NewValue(objectType, values)
+	}
+
+	cases := []struct {
+		name            string
+		state           tftypes.Value
+		requiresReplace bool
+	}{
+		{name: "create", state: tftypes.NewValue(objectType, nil)},
+		{name: "payment term", state: newValue(tftypes.NewValue(tftypes.String, "payment"))},
+		{name: "dynamic term", state: newValue(tftypes.NewValue(tftypes.String, "dynamic")), requiresReplace: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newValue(tftypes.NewValue(tftypes.String, nil))},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue))},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: c.state},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("type"), &actual)...)
+			if actual.ValueString() != "payment" {
+				t.Errorf("expected type payment, got %s", actual)
+			}
+			requiresReplace := resp.RequiresReplace.Contains(path.Root("type"))
+			if requiresReplace != c.requiresReplace {
+				t.Errorf("expected requires replace: %t, got %v", c.requiresReplace, resp.RequiresReplace)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != c.requiresReplace {
+				t.Errorf("expected warning: %t, got %v", c.requiresReplace, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceModifyPlanCurrencySymbol(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{defaultAid: types.StringNull()}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	newValue := func(paymentCurrency, currencySymbol tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["aid"] = tftypes.NewValue(tftypes.String, "AIDXXXXXXX")
+		values["name"] = tftypes.NewValue(tftypes.String, "example")
+		values["payment_currency"] = paymentCurrency
+		values["currency_symbol"] = currencySymbol
+		return tftypes.NewValue(objectType, values)
+	}
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	prior := newValue(tftypes.NewValue(tftypes.String, "USD"), tftypes.NewValue(tftypes.String, "$"))
+
+	unset := tftypes.NewValue(tftypes.String, nil)
+	dollar := tftypes.NewValue(tftypes.String, "$")
+	create := tftypes.NewValue(objectType, nil)
+
+	cases := []struct {
+		name            string
+		state           tftypes.Value
+		paymentCurrency tftypes.Value
+		configured      tftypes.Value
+		currencySymbol  tftypes.Value
+		expected        types.String
+	}{
+		{name: "create", state: create, paymentCurrency: tftypes.NewValue(tftypes.String, "EUR"), configured: unset, currencySymbol: unknown, expected: types.StringValue("€")},
+		{name: "create with an unknown currency", state: create, paymentCurrency: tftypes.NewValue(tftypes.String, "XTS"), configured: unset, currencySymbol: unknown, expected: types.StringValue("XTS")},
+		{name: "create with a configured symbol", state: create, paymentCurrency: tftypes.NewValue(tftypes.String, "EUR"), configured: tftypes.NewValue(tftypes.String, "EUR "), currencySymbol: tftypes.NewValue(tftypes.String, "EUR "), expected: types.StringValue("EUR ")},
+		{name: "create with a currency not known yet", state: create, paymentCurrency: unknown, configured: unset, currencySymbol: unknown, expected: types.StringUnknown()},
+		// the symbol in the state is planned by UseStateForUnknown
+		{name: "currency unchanged", state: prior, paymentCurrency: tftypes.NewValue(tftypes.String, "USD"), configured: unset, currencySymbol: dollar, expected: types.StringValue("$")},
+		{name: "currency changed", state: prior, paymentCurrency: tftypes.NewValue(tftypes.String, "EUR"), configured: unset, currencySymbol: dollar, expected: types.StringValue("€")},
+		{name: "currency changed with a configured symbol", state: prior, paymentCurrency: tftypes.NewValue(tftypes.String, "EUR"), configured: dollar, currencySymbol: dollar, expected: types.StringValue("$")},
+		{name: "currency not known yet", state: prior, paymentCurrency: unknown, configured: unset, currencySymbol: dollar, expected: types.StringUnknown()},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newValue(c.paymentCurrency, c.configured)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(c.paymentCurrency, c.currencySymbol)},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: c.state},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("currency_symbol"), &actual)...)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected currency_symbol %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceModifyPlanBillingPlanDescription(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{defaultAid: types.StringNull()}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	newValue := func(billingPlan string, description, firstPrice tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["aid"] = tftypes.NewValue(tftypes.String, "AIDXXXXXXX")
+		values["name"] = tftypes.NewValue(tftypes.String, "example")
+		values["payment_currency"] = tftypes.NewValue(tftypes.String, "USD")
+		values["currency_symbol"] = tftypes.NewValue(tftypes.String, "$")
+		values["payment_billing_plan"] = tftypes.NewValue(tftypes.String, billingPlan)
+		values["payment_billing_plan_description"] = description
+		values["payment_first_price"] = firstPrice
+		return tftypes.NewValue(objectType, values)
+	}
+	unknownDescription := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	unknownFirstPrice := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+	state := newValue("[19.99 USD|1 month|*]", tftypes.NewValue(tftypes.String, "$19.99 per month"), tftypes.NewValue(tftypes.Number, 19.99))
+
+	cases := []struct {
+		name        string
+		billingPlan string
+		expected    types.String
+	}{
+		{name: "unchanged billing plan", billingPlan: "[19.99 USD|1 month|*]", expected: types.StringValue("$19.99 per month")},
+		{name: "changed billing plan", billingPlan: "[9.99 USD|1 month|*]", expected: types.StringUnknown()},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newValue(c.billingPlan, tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.Number, nil))},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(c.billingPlan, unknownDescription, unknownFirstPrice)},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var description types.String
+			var firstPrice types.Float64
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("payment_billing_plan_description"), &description)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("payment_first_price"), &firstPrice)...)
+			if !description.Equal(c.expected) {
+				t.Errorf("expected payment_billing_plan_description %s, got %s", c.expected, description)
+			}
+			if firstPrice.IsUnknown() != c.expected.IsUnknown() {
+				t.Errorf("expected payment_first_price to be unknown: %t, got %s", c.expected.IsUnknown(), firstPrice)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceCreateChangeOptionsAfterTerm(t *testing.T) {
+	ctx := context.Background()
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/publisher/term/payment/create":
+			fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMFROM","name":"Monthly","type":"payment","payment_is_subscription":true}}`)
+		case "/publisher/term/change/option/create":
+			if err := req.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			if actual := req.PostForm.Get("from_term_id"); actual != "TMFROM" {
+				t.Errorf("expected from_term_id TMFROM, got %s", actual)
+			}
+			toTermId := req.PostForm.Get("to_term_id")
+			fmt.Fprintf(w, `{"code":0,"term_change_option":{"term_change_option_id":"TCO%s","from_term_id":"TMFROM","to_term_id":"%s","billing_timing":"0"}}`, toTermId, toTermId)
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &PaymentTermV2Resource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	diags := plan.SetAttribute(ctx, path.Root("aid"), types.StringValue("example"))
+	diags.Append(plan.SetAttribute(ctx, path.Root("name"), types.StringValue("Monthly"))...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("payment_billing_plan"), types.StringValue("[19.99 USD|1 month|*]"))...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("change_options"), []PaymentTermChangeOptionModel{
+		{TermChangeOptionId: types.StringUnknown(), ToTermId: types.StringValue("TMYEARLY"), BillingTiming: types.StringValue("0"), ImmediateAccess: types.BoolValue(true), ProrateAccess: types.BoolValue(true)},
+		{TermChangeOptionId: types.StringUnknown(), ToTermId: types.StringValue("TMWEEKLY"), BillingTiming: types.StringValue("1"), ImmediateAccess: types.BoolValue(false), ProrateAccess: types.BoolValue(false)},
+	})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	expectedRequests := []string{"/publisher/term/payment/create", "/publisher/term/change/option/create", "/publisher/term/change/option/create"}
+	if fmt.Sprint(requests) != fmt.Sprint(expectedRequests) {
+		t.Errorf("expected requests %v, got %v", expectedRequests, requests)
+	}
+	var actual []PaymentTermChangeOptionModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("change_options"), &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(actual) != 2 || actual[0].TermChangeOptionId.ValueString() != "TCOTMYEARLY" || actual[1].TermChangeOptionId.ValueString() != "TCOTMWEEKLY" {
+		t.Errorf("unexpected change options: %v", actual)
+	}
+}
+
+func TestPaymentTermV2ResourceCreateLeavesNoUnknowns(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/publisher/term/payment/create" {
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"Monthly","type":"payment","create_date":1735657200,"update_date":1735657200,`+
+			`"payment_billing_plan":"[19.99 EUR|1 month|*]","payment_billing_plan_description":"€19.99 per month","payment_first_price":19.99,"payment_currency":"EUR","currency_symbol":"€"}}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &PaymentTermV2Resource{client: client, defaultAid: types.StringNull(), dateLocation: time.UTC}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	cases := []struct {
+		name            string
+		paymentCurrency tftypes.Value
+		currencySymbol  tftypes.Value
+		expected        string
+	}{
+		{name: "currency", paymentCurrency: tftypes.NewValue(tftypes.String, "EUR"), currencySymbol: tftypes.NewValue(tftypes.String, nil), expected: "€"},
+		{name: "default currency", paymentCurrency: tftypes.NewValue(tftypes.String, nil), currencySymbol: tftypes.NewValue(tftypes.String, nil), expected: "$"},
+		{name: "configured symbol", paymentCurrency: tftypes.NewValue(tftypes.String, "EUR"), currencySymbol: tftypes.NewValue(tftypes.String, "EUR "), expected: "EUR "},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["aid"] = tftypes.NewValue(tftypes.String, "example")
+			values["rid"] = tftypes.NewValue(tftypes.String, "RXXXXXX")
+			values["name"] = tftypes.NewValue(tftypes.String, "Monthly")
+			values["payment_billing_plan"] = tftypes.NewValue(tftypes.String, "[19.99 EUR|1 month|*]")
+			values["payment_currency"] = c.paymentCurrency
+			values["currency_symbol"] = c.currencySymbol
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+			plan := newTestCreatePlan(t, r, schemaResp.Schema, config)
+
+			resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			r.Create(ctx, resource.CreateRequest{Config: config, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			// terraform rejects a state with unknown values after apply
+			if !resp.State.Raw.IsFullyKnown() {
+				_ = tftypes.Walk(resp.State.Raw, func(path *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+					if !value.IsKnown() {
+						t.Errorf("%s is unknown after create", path)
+					}
+					return value.IsKnown(), nil
+				})
+			}
+			var actual types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("currency_symbol"), &actual)...)
+			if actual.ValueString() != c.expected {
+				t.Errorf("expected currency_symbol %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceCreateVoucheringPolicy(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"Monthly","type":"payment",`+
+			`"vouchering_policy":{"vouchering_policy_id":"VP1","vouchering_policy_billing_plan":"[19.99 USD|1 month|*]","vouchering_policy_billing_plan_description":"$19.99 per month","vouchering_policy_redemption_url":"https://example.com/redeem"}}}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &PaymentTermV2Resource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["aid"] = tftypes.NewValue(tftypes.String, "example")
+	values["rid"] = tftypes.NewValue(tftypes.String, "RXXXXXX")
+	values["name"] = tftypes.NewValue(tftypes.String, "Monthly")
+	// terraform plans the computed vouchering_policy as unknown on create
+	values["vouchering_policy"] = tftypes.NewValue(objectType.AttributeTypes["vouchering_policy"], tftypes.UnknownValue)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual *VoucheringPolicyResourceModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("vouchering_policy"), &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if actual == nil || actual.VoucheringPolicyId.ValueString() != "VP1" || actual.VoucheringPolicyRedemptionUrl.ValueString() != "https://example.com/redeem" {
+		t.Errorf("unexpected vouchering_policy: %v", actual)
+	}
+}
+
+func TestPaymentTermV2ResourceModifyPlanChangeOptions(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{defaultAid: types.StringNull()}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	newValue := func(options []PaymentTermChangeOptionModel) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["aid"] = tftypes.NewValue(tftypes.String, "AIDXXXXXXX")
+		values["payment_currency"] = tftypes.NewValue(tftypes.String, "USD")
+		values["currency_symbol"] = tftypes.NewValue(tftypes.String, "$")
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+		diags := state.SetAttribute(ctx, path.Root("change_options"), options)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return state.Raw
+	}
+	yearly := PaymentTermChangeOptionModel{ToTermId: types.StringValue("TMYEARLY"), BillingTiming: types.StringValue("0"), ImmediateAccess: types.BoolValue(true), ProrateAccess: types.BoolValue(false)}
+	weekly := PaymentTermChangeOptionModel{ToTermId: types.StringValue("TMWEEKLY"), BillingTiming: types.StringValue("1"), ImmediateAccess: types.BoolValue(false), ProrateAccess: types.BoolValue(false)}
+	withId := func(option PaymentTermChangeOptionModel, id types.String) PaymentTermChangeOptionModel {
+		option.TermChangeOptionId = id
+		return option
+	}
+	state := newValue([]PaymentTermChangeOptionModel{withId(yearly, types.StringValue("TCO1"))})
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newValue([]PaymentTermChangeOptionModel{withId(weekly, types.StringNull()), withId(yearly, types.StringNull())})},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue([]PaymentTermChangeOptionModel{withId(weekly, types.StringUnknown()), withId(yearly, types.StringUnknown())})},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var actual []PaymentTermChangeOptionModel
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("change_options"), &actual)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(actual) != 2 || !actual[0].TermChangeOptionId.IsUnknown() || actual[1].TermChangeOptionId.ValueString() != "TCO1" {
+		t.Errorf("expected only the new change option to be created, got %v", actual)
+	}
+}
+
+func TestPaymentTermV2ResourceValidateConfigDeliveryZone(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	cases := []struct {
+		name           string
+		collectAddress tftypes.Value
+		deliveryZone   tftypes.Value
+		expectedError  bool
+	}{
+		{
+			name:           "delivery zone without collect_address",
+			collectAddress: tftypes.NewValue(tftypes.Bool, nil),
+			deliveryZone:   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "DZ1")}),
+			expectedError:  true,
+		},
+		{
+			name:           "delivery zone with collect_address disabled",
+			collectAddress: tftypes.NewValue(tftypes.Bool, false),
+			deliveryZone:   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "DZ1")}),
+			expectedError:  true,
+		},
+		{
+			name:           "delivery zone with collect_address enabled",
+			collectAddress: tftypes.NewValue(tftypes.Bool, true),
+			deliveryZone:   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "DZ1")}),
+		},
+		{
+			name:           "no delivery zone",
+			collectAddress: tftypes.NewValue(tftypes.Bool, nil),
+			deliveryZone:   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["collect_address"] = c.collectAddress
+			values["delivery_zone"] = c.deliveryZone
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+			if c.expectedError && resp.Diagnostics.Errors()[0].Summary() != "Delivery Zone Without Address Collection" {
+				t.Errorf("unexpected diagnostic: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceValidateConfigFreeTrial(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	cases := []struct {
+		name          string
+		hasFreeTrial  tftypes.Value
+		trialPeriod   tftypes.Value
+		trialPrice    tftypes.Value
+		expectedError bool
+	}{
+		{
+			name:          "trial without payment_has_free_trial",
+			hasFreeTrial:  tftypes.NewValue(tftypes.Bool, nil),
+			trialPeriod:   tftypes.NewValue(tftypes.String, "7 days"),
+			trialPrice:    tftypes.NewValue(tftypes.Number, nil),
+			expectedError: true,
+		},
+		{
+			name:          "trial price with payment_has_free_trial disabled",
+			hasFreeTrial:  tftypes.NewValue(tftypes.Bool, false),
+			trialPeriod:   tftypes.NewValue(tftypes.String, "7 days"),
+			trialPrice:    tftypes.NewValue(tftypes.Number, 1),
+			expectedError: true,
+		},
+		{
+			name:          "trial price without trial period",
+			hasFreeTrial:  tftypes.NewValue(tftypes.Bool, true),
+			trialPeriod:   tftypes.NewValue(tftypes.String, nil),
+			trialPrice:    tftypes.NewValue(tftypes.Number, 1),
+			expectedError: true,
+		},
+		{
+			name:         "trial with payment_has_free_trial enabled",
+			hasFreeTrial: tftypes.NewValue(tftypes.Bool, true),
+			trialPeriod:  tftypes.NewValue(tftypes.String, "7 days"),
+			trialPrice:   tftypes.NewValue(tftypes.Number, 1),
+		},
+		{
+			name:         "no trial",
+			hasFreeTrial: tftypes.NewValue(tftypes.Bool, nil),
+			trialPeriod:  tftypes.NewValue(tftypes.String, nil),
+			trialPrice:   tftypes.NewValue(tftypes.Number, nil),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["payment_has_free_trial"] = c.hasFreeTrial
+			values["payment_trial_period"] = c.trialPeriod
+			values["payment_trial_price"] = c.trialPrice
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceValidateConfigStartInFuture(t *testing.T) {
+	ctx := context.Background()
+	r := &PaymentTermV2Resource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	cases := []struct {
+		name                 string
+		allowStartInFuture   tftypes.Value
+		maximumDaysInAdvance tftypes.Value
+		expectedError        bool
+	}{
+		{
+			name:                 "maximum_days_in_advance without allow_start_in_future",
+			allowStartInFuture:   tftypes.NewValue(tftypes.Bool, nil),
+			maximumDaysInAdvance: tftypes.NewValue(tftypes.Number, 30),
+			expectedError:        true,
+		},
+		{
+			name:                 "maximum_days_in_advance with allow_start_in_future disabled",
+			allowStartInFuture:   tftypes.NewValue(tftypes.Bool, false),
+			maximumDaysInAdvance: tftypes.NewValue(tftypes.Number, 30),
+			expectedError:        true,
+		},
+		{
+			name:                 "maximum_days_in_advance with allow_start_in_future enabled",
+			allowStartInFuture:   tftypes.NewValue(tftypes.Bool, true),
+			maximumDaysInAdvance: tftypes.NewValue(tftypes.Number, 30),
+		},
+		{
+			name:                 "allow_start_in_future without maximum_days_in_advance",
+			allowStartInFuture:   tftypes.NewValue(tftypes.Bool, true),
+			maximumDaysInAdvance: tftypes.NewValue(tftypes.Number, nil),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["allow_start_in_future"] = c.allowStartInFuture
+			values["maximum_days_in_advance"] = c.maximumDaysInAdvance
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, &resp)
+			if resp.Diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error: %t, got %v", c.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceReadSharedAccount(t *testing.T) {
+	cases := []struct {
+		name                        string
+		sharedAccountCount          types.Int32
+		sharedRedemptionUrl         types.String
+		response                    string
+		expectedSharedAccountCount  types.Int32
+		expectedSharedRedemptionUrl types.String
+	}{
+		{
+			name:                        "shared subscription",
+			sharedAccountCount:          types.Int32Value(3),
+			sharedRedemptionUrl:         types.StringValue("https://example.com/redeem"),
+			response:                    `"shared_account_count":3,"shared_redemption_url":"https://example.com/redeem"`,
+			expectedSharedAccountCount:  types.Int32Value(3),
+			expectedSharedRedemptionUrl: types.StringValue("https://example.com/redeem"),
+		},
+		{
+			name:                        "no shared subscription",
+			sharedAccountCount:          types.Int32Null(),
+			sharedRedemptionUrl:         types.StringNull(),
+			response:                    `"shared_account_count":0,"shared_redemption_url":""`,
+			expectedSharedAccountCount:  types.Int32Null(),
+			expectedSharedRedemptionUrl: types.StringNull(),
+		},
+		{
+			name:                        "shared subscription changed outside terraform",
+			sharedAccountCount:          types.Int32Null(),
+			sharedRedemptionUrl:         types.StringNull(),
+			response:                    `"shared_account_count":5,"shared_redemption_url":null`,
+			expectedSharedAccountCount:  types.Int32Value(5),
+			expectedSharedRedemptionUrl: types.StringNull(),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/term/get" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"Monthly","type":"payment","payment_billing_plan":"[19.99 USD|1 month|*]",%s}}`, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &PaymentTermV2Resource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+			diags := state.SetAttribute(ctx, path.Root("term_id"), types.StringValue("TMXXXXXX"))
+			diags.Append(state.SetAttribute(ctx, path.Root("shared_account_count"), c.sharedAccountCount)...)
+			diags.Append(state.SetAttribute(ctx, path.Root("shared_redemption_url"), c.sharedRedemptionUrl)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual PaymentTermV2ResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !actual.SharedAccountCount.Equal(c.expectedSharedAccountCount) {
+				t.Errorf("expected shared_account_count to be %s, got %s", c.expectedSharedAccountCount, actual.SharedAccountCount)
+			}
+			if !actual.SharedRedemptionUrl.Equal(c.expectedSharedRedemptionUrl) {
+				t.Errorf("expected shared_redemption_url to be %s, got %s", c.expectedSharedRedemptionUrl, actual.SharedRedemptionUrl)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceReadCurrencySymbol(t *testing.T) {
+	cases := []struct {
+		name           string
+		currencySymbol types.String
+		response       string
+		expected       types.String
+	}{
+		{name: "derived symbol", currencySymbol: types.StringValue("€"), response: `"currency_symbol":"€"`, expected: types.StringValue("€")},
+		{name: "configured symbol", currencySymbol: types.StringValue("EUR "), response: `"currency_symbol":"€"`, expected: types.StringValue("EUR ")},
+		{name: "import", currencySymbol: types.StringNull(), response: `"currency_symbol":"€"`, expected: types.StringValue("€")},
+		{name: "import without a symbol", currencySymbol: types.StringNull(), response: `"currency_symbol":""`, expected: types.StringValue("€")},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"Monthly","type":"payment","payment_billing_plan":"[19.99 EUR|1 month|*]","payment_currency":"EUR",%s}}`, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &PaymentTermV2Resource{client: client}
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := newTestState(t, schemaResp.Schema, nil)
+			diags := state.SetAttribute(ctx, path.Root("term_id"), types.StringValue("TMXXXXXX"))
+			diags.Append(state.SetAttribute(ctx, path.Root("currency_symbol"), c.currencySymbol)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("currency_symbol"), &actual)...)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected currency_symbol %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPaymentTermV2ResourceReadUnexpectedResponse(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// a list response decodes into TermResult without an error
+		fmt.Fprint(w, `{"code":0,"terms":[{"aid":"example","term_id":"TMXXXXXX","name":"Monthly"}]}`)
+	}))
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &PaymentTermV2Resource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	diags := state.SetAttribute(ctx, path.Root("term_id"), types.StringValue("TMXXXXXX"))
+	diags.Append(state.SetAttribute(ctx, path.Root("name"), types.StringValue("Monthly"))...)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for the response without term_id")
+	}
+	if !resp.State.Raw.Equal(state.Raw) {
+		t.Errorf("expected the state to be unchanged, got %s", resp.State.Raw)
+	}
+}
+
+func TestPaymentTermResourcesReadProductCategory(t *testing.T) {
+	resources := map[string]resource.Resource{
+		"piano_payment_term":    &PaymentTermResource{},
+		"piano_payment_term_v2": &PaymentTermV2Resource{},
+	}
+	cases := []struct {
+		name            string
+		productCategory types.String
+		response        string
+		expected        types.String
+	}{
+		{
+			name:            "unset product category",
+			productCategory: types.StringNull(),
+			response:        `""`,
+			expected:        types.StringNull(),
+		},
+		{
+			name:            "product category",
+			productCategory: types.StringValue("news"),
+			response:        `"news"`,
+			expected:        types.StringValue("news"),
+		},
+		{
+			name:            "product category removed outside terraform",
+			productCategory: types.StringValue("news"),
+			response:        `""`,
+			expected:        types.StringValue(""),
+		},
+		{
+			name:            "product category set outside terraform",
+			productCategory: types.StringNull(),
+			response:        `"news"`,
+			expected:        types.StringValue("news"),
+		},
+	}
+	for resourceName, r := range resources {
+		for _, c := range cases {
+			t.Run(fmt.Sprintf("%s/%s", resourceName, c.name), func(t *testing.T) {
+				ctx := context.Background()
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					if req.URL.Path != "/publisher/term/get" {
+						t.Errorf("unexpected request: %s", req.URL)
+					}
+					w.Header().Set("Content-Type", "application/json")
+					fmt.Fprintf(w, `{"code":0,"term":{"aid":"example","term_id":"TMXXXXXX","name":"Monthly","type":"payment","payment_billing_plan":"[19.99 USD|1 month|*]","product_category":%s}}`, c.response)
+				}))
+				defer server.Close()
+				client, err := piano_publisher.NewClient(server.URL)
+				if err != nil {
+					t.Fatal(err)
+				}
+				switch r := r.(type) {
+				case *PaymentTermResource:
+					r.client = client
+				case *PaymentTermV2Resource:
+					r.client = client
+				}
+
+				schemaResp := resource.SchemaResponse{}
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+				objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+				values := map[string]tftypes.Value{}
+				for name, attributeType := range objectType.AttributeTypes {
+					values[name] = tftypes.NewValue(attributeType, nil)
+				}
+				state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+				diags := state.SetAttribute(ctx, path.Root("term_id"), types.StringValue("TMXXXXXX"))
+				diags.Append(state.SetAttribute(ctx, path.Root("product_category"), c.productCategory)...)
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				resp := resource.ReadResponse{State: state}
+				r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				var actual types.String
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("product_category"), &actual)...)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				if !actual.Equal(c.expected) {
+					t.Errorf("expected product_category to be %s, got %s", c.expected, actual)
+				}
+			})
+		}
+	}
+}
+
+func TestPaymentBillingPlanWithTrialRoundTrip(t *testing.T) {
+	plan := PaymentTermV2ResourceModel{
+		PaymentBillingPlan: types.StringValue("[19.99 USD|1 month|*]"),
+		PaymentTrialPeriod: types.StringValue("7 days"),
+		PaymentTrialPrice:  types.Float64Null(),
+	}
+	diagnostics := diag.Diagnostics{}
+	billingPlan := paymentBillingPlanWithTrial(plan, &diagnostics)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", diagnostics)
+	}
+	if *billingPlan != "[0 USD|7 days|1][19.99 USD|1 month|*]" {
+		t.Errorf("unexpected billing plan: %s", *billingPlan)
+	}
+
+	state := plan
+	reconcileFreeTrial(&state, *billingPlan)
+	if !state.PaymentBillingPlan.Equal(plan.PaymentBillingPlan) || !state.PaymentTrialPeriod.Equal(plan.PaymentTrialPeriod) || !state.PaymentTrialPrice.IsNull() {
+		t.Errorf("expected no drift, got %s %s %s", state.PaymentBillingPlan, state.PaymentTrialPeriod, state.PaymentTrialPrice)
+	}
+
+	plan.PaymentTrialPrice = types.Float64Value(1.5)
+	billingPlan = paymentBillingPlanWithTrial(plan, &diagnostics)
+	if *billingPlan != "[1.5 USD|7 days|1][19.99 USD|1 month|*]" {
+		t.Errorf("unexpected billing plan: %s", *billingPlan)
+	}
+	state = plan
+	reconcileFreeTrial(&state, *billingPlan)
+	if !state.PaymentTrialPrice.Equal(types.Float64Value(1.5)) {
+		t.Errorf("expected payment_trial_price 1.5, got %s", state.PaymentTrialPrice)
+	}
+
+	// the trial is reported as removed when piano.io returns a billing plan without it
+	reconcileFreeTrial(&state, "[19.99 USD|1 month|*]")
+	if !state.PaymentTrialPeriod.IsNull() || !state.PaymentTrialPrice.IsNull() {
+		t.Errorf("expected no trial, got %s %s", state.PaymentTrialPeriod, state.PaymentTrialPrice)
+	}
+}
+
+func TestReconcileFreeTrialNormalizedBillingPlan(t *testing.T) {
+	cases := []struct {
+		name                string
+		trialPeriod         types.String
+		trialPrice          types.Float64
+		billingPlan         string
+		expectedBillingPlan string
+		expectedTrialPeriod types.String
+		expectedTrialPrice  types.Float64
+	}{
+		{
+			name:                "normalized prices and periods",
+			trialPeriod:         types.StringValue("7 days"),
+			trialPrice:          types.Float64Null(),
+			billingPlan:         "[0.00 USD|7 days|1][19.990 USD|1 months|*]",
+			expectedBillingPlan: "[19.99 USD|1 month|*]",
+			expectedTrialPeriod: types.StringValue("7 days"),
+			expectedTrialPrice:  types.Float64Null(),
+		},
+		{
+			name:                "normalized trial period",
+			trialPeriod:         types.StringValue("1 week"),
+			trialPrice:          types.Float64Value(1.5),
+			billingPlan:         "[1.50 USD|1 weeks|1][19.99 USD|1 month|*]",
+			expectedBillingPlan: "[19.99 USD|1 month|*]",
+			expectedTrialPeriod: types.StringValue("1 week"),
+			expectedTrialPrice:  types.Float64Value(1.5),
+		},
+		{
+			name:                "changed outside terraform",
+			trialPeriod:         types.StringValue("7 days"),
+			trialPrice:          types.Float64Null(),
+			billingPlan:         "[0.00 USD|14 days|1][24.99 USD|1 month|*]",
+			expectedBillingPlan: "[24.99 USD|1 month|*]",
+			expectedTrialPeriod: types.StringValue("14 days"),
+			expectedTrialPrice:  types.Float64Null(),
+		},
+		{
+			name:                "without trial",
+			trialPeriod:         types.StringNull(),
+			trialPrice:          types.Float64Null(),
+			billingPlan:         "[19.99 USD|1 months|*]",
+			expectedBillingPlan: "[19.99 USD|1 month|*]",
+			expectedTrialPeriod: types.StringNull(),
+			expectedTrialPrice:  types.Float64Null(),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := PaymentTermV2ResourceModel{
+				PaymentBillingPlan: types.StringValue("[19.99 USD|1 month|*]"),
+				PaymentTrialPeriod: c.trialPeriod,
+				PaymentTrialPrice:  c.trialPrice,
+			}
+			reconcileFreeTrial(&state, c.billingPlan)
+			if state.PaymentBillingPlan.ValueString() != c.expectedBillingPlan || !state.PaymentTrialPeriod.Equal(c.expectedTrialPeriod) || !state.PaymentTrialPrice.Equal(c.expectedTrialPrice) {
+				t.Errorf("expected %s %s %s, got %s %s %s", c.expectedBillingPlan, c.expectedTrialPeriod, c.expectedTrialPrice, state.PaymentBillingPlan, state.PaymentTrialPeriod, state.PaymentTrialPrice)
+			}
+		})
+	}
+}
+
+func TestPaymentBillingPlanTableListFrom(t *testing.T) {
+	ctx := context.Background()
+	price := 1200.0
+	period := "1 month"
+	dateValue := 1700000000
+	list, diags := PaymentBillingPlanTableListFrom(ctx, []piano_publisher.PaymentBillingPlanTable{
+		{PriceValue: &price, Period: &period, DateValue: &dateValue},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	var actual []PaymentBillingPlanTableResourceModel
+	diags = list.ElementsAs(ctx, &actual, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 billing cycle, got %d", len(actual))
+	}
+	if actual[0].PriceValue.ValueFloat64() != price || actual[0].Period.ValueString() != period || actual[0].DateValue.ValueInt64() != int64(dateValue) {
+		t.Errorf("unexpected billing cycle: %v", actual[0])
+	}
+	if !actual[0].Currency.IsNull() {
+		t.Errorf("expected currency to be null, got %s", actual[0].Currency)
+	}
+}
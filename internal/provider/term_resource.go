@@ -4,9 +4,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -43,3 +49,51 @@ func TermResourceIdFromString(input string) (*TermResourceId, error) {
 	}
 	return &TermResourceId{Aid: parts[0], TermId: parts[1]}, nil
 }
+
+// planTermType plans type as expected, the term type a resource creates.
+// piano.io API cannot change the type of a term, so the term is replaced when the prior state holds another type.
+// It happens when a term of another type is imported or the term is converted in piano.io dashboard.
+func planTermType(ctx context.Context, expected piano_publisher.TermType, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), types.StringValue(string(expected)))...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var prior types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &prior)...)
+	if prior.IsNull() || prior.IsUnknown() || prior.ValueString() == string(expected) {
+		return
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("type"),
+		"Term Will Be Replaced",
+		fmt.Sprintf("The term is a %s term, but this resource manages %s terms. piano.io API cannot change the type of a term, so the term will be deleted and a new %s term will be created. "+
+			"Existing subscriptions and offers referring to the term are not moved to the new term.", prior.ValueString(), expected, expected),
+	)
+}
+
+// sortedTermChangeOptions returns change options sorted by term_change_option_id.
+// piano.io API does not guarantee the order of change_options, so it is sorted to avoid spurious diffs.
+func sortedTermChangeOptions(data []piano_publisher.TermChangeOption) []piano_publisher.TermChangeOption {
+	sorted := append([]piano_publisher.TermChangeOption{}, data...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TermChangeOptionId < sorted[j].TermChangeOptionId
+	})
+	return sorted
+}
+
+// sortedExternalAPIFields returns external API form fields sorted by order and then by field_name.
+// piano.io API does not guarantee the order of external_api_form_fields, so it is sorted to avoid spurious diffs.
+func sortedExternalAPIFields(data []piano_publisher.ExternalAPIField) []piano_publisher.ExternalAPIField {
+	sorted := append([]piano_publisher.ExternalAPIField{}, data...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Order != sorted[j].Order {
+			return sorted[i].Order < sorted[j].Order
+		}
+		return sorted[i].FieldName < sorted[j].FieldName
+	})
+	return sorted
+}
@@ -0,0 +1,194 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// termListPageSize is the number of terms fetched per request.
+const termListPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TermsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TermsDataSource{}
+)
+
+func NewTermsDataSource() datasource.DataSource {
+	return &TermsDataSource{}
+}
+
+// TermsDataSource defines the data source implementation.
+type TermsDataSource struct {
+	client piano_publisher.ClientInterface
+}
+
+// TermsDataSourceModel describes the data source data model.
+type TermsDataSourceModel struct {
+	Aid    types.String           `tfsdk:"aid"`    // The application ID
+	Rid    types.String           `tfsdk:"rid"`    // The resource ID
+	Type   types.String           `tfsdk:"type"`   // The term type
+	Offset types.Int32            `tfsdk:"offset"` // The offset from which to start listing terms
+	Limit  types.Int32            `tfsdk:"limit"`  // The maximum number of terms to fetch
+	Terms  []TermsDataSourceEntry `tfsdk:"terms"`  // The terms of the application
+}
+
+type TermsDataSourceEntry struct {
+	TermId   types.String `tfsdk:"term_id"`  // The term ID
+	Name     types.String `tfsdk:"name"`     // The term name
+	Type     types.String `tfsdk:"type"`     // The term type
+	Disabled types.Bool   `tfsdk:"disabled"` // Whether the term is disabled
+}
+
+func (*TermsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terms"
+}
+
+func (*TermsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Terms data source. This data source is used to list terms of an application.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				MarkdownDescription: "The application ID",
+				Required:            true,
+				Validators:          aidValidators(),
+			},
+			"rid": schema.StringAttribute{
+				MarkdownDescription: "The resource ID. Only the terms of this resource are listed when this value is set.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The term type. Only the terms of this type are listed when this value is set.",
+				Optional:            true,
+			},
+			"offset": schema.Int32Attribute{
+				MarkdownDescription: "The offset from which to start listing terms. Defaults to 0.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "The maximum number of terms to fetch from piano.io API. All the terms are fetched when this value is null.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"terms": schema.ListNestedAttribute{
+				MarkdownDescription: "The terms of the application",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"term_id": schema.StringAttribute{
+							MarkdownDescription: "The term ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The term name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The term type",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the term is disabled",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TermsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.publisherClient
+}
+
+func (d *TermsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TermsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := piano_publisher.GetPublisherTermListParams{
+		Aid:    state.Aid.ValueString(),
+		Rid:    state.Rid.ValueStringPointer(),
+		Type:   state.Type.ValueStringPointer(),
+		Offset: state.Offset.ValueInt32(),
+		Limit:  termListPageSize,
+	}
+
+	entries := []TermsDataSourceEntry{}
+	for {
+		if !state.Limit.IsNull() {
+			remaining := state.Limit.ValueInt32() - int32(len(entries))
+			if remaining <= 0 {
+				break
+			}
+			params.Limit = min(remaining, termListPageSize)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("fetching terms in %s (offset: %d, limit: %d)", params.Aid, params.Offset, params.Limit))
+		response, err := d.client.GetPublisherTermList(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch terms, got error: %s", err))
+			return
+		}
+		anyResponse, err := syntax.SuccessfulResponseFrom(response, &resp.Diagnostics)
+		if err != nil {
+			return
+		}
+
+		result := piano_publisher.TermArrayResult{}
+		err = json.Unmarshal(anyResponse.Raw, &result)
+		if err != nil {
+			resp.Diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+			return
+		}
+		for _, term := range result.Terms {
+			entries = append(entries, TermsDataSourceEntryFrom(term))
+		}
+		params.Offset += int32(len(result.Terms))
+		if len(result.Terms) < int(params.Limit) {
+			break
+		}
+	}
+
+	state.Terms = entries
+	tflog.Trace(ctx, "read a terms data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func TermsDataSourceEntryFrom(data piano_publisher.Term) TermsDataSourceEntry {
+	ret := TermsDataSourceEntry{}
+	ret.TermId = types.StringValue(data.TermId)
+	ret.Name = types.StringValue(data.Name)
+	ret.Type = types.StringValue(string(data.Type))
+	ret.Disabled = types.BoolValue(data.Disabled != nil && *data.Disabled)
+	return ret
+}
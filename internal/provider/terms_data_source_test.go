@@ -0,0 +1,132 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTermsDataSourceRead(t *testing.T) {
+	// 150 terms over two pages, where every third term is disabled.
+	const total = 150
+	cases := []struct {
+		name          string
+		offset        types.Int32
+		limit         types.Int32
+		expectedFirst string
+		expectedCount int
+	}{
+		{name: "all terms", offset: types.Int32Null(), limit: types.Int32Null(), expectedFirst: "TM0", expectedCount: 150},
+		{name: "limited", offset: types.Int32Null(), limit: types.Int32Value(120), expectedFirst: "TM0", expectedCount: 120},
+		{name: "offset", offset: types.Int32Value(140), limit: types.Int32Null(), expectedFirst: "TM140", expectedCount: 10},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/term/list" {
+					t.Errorf("unexpected request: %s", req.URL.Path)
+				}
+				query := req.URL.Query()
+				if query.Get("aid") != "example" || query.Get("rid") != "RID1" || query.Get("type") != "payment" {
+					t.Errorf("expected aid, rid and type to be sent, got %s", req.URL.RawQuery)
+				}
+				offset, _ := strconv.Atoi(query.Get("offset"))
+				limit, _ := strconv.Atoi(query.Get("limit"))
+				terms := []map[string]any{}
+				for i := offset; i < min(offset+limit, total); i++ {
+					term := map[string]any{"term_id": fmt.Sprintf("TM%d", i), "name": fmt.Sprintf("Term %d", i), "type": "payment"}
+					if i%3 == 0 {
+						term["disabled"] = true
+					}
+					terms = append(terms, term)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "terms": terms, "total": total}); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer server.Close()
+			client, err := piano_publisher.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &TermsDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &TermsDataSourceModel{
+				Aid:    types.StringValue("example"),
+				Rid:    types.StringValue("RID1"),
+				Type:   types.StringValue("payment"),
+				Offset: c.offset,
+				Limit:  c.limit,
+			})
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual TermsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if len(actual.Terms) != c.expectedCount {
+				t.Fatalf("expected %d terms, got %d", c.expectedCount, len(actual.Terms))
+			}
+			if actual.Terms[0].TermId.ValueString() != c.expectedFirst {
+				t.Errorf("expected the first term %s, got %s", c.expectedFirst, actual.Terms[0].TermId)
+			}
+		})
+	}
+}
+
+func TestTermsDataSourceEntryFrom(t *testing.T) {
+	disabled := true
+	cases := []struct {
+		name     string
+		term     piano_publisher.Term
+		expected TermsDataSourceEntry
+	}{
+		{
+			name: "disabled",
+			term: piano_publisher.Term{TermId: "TM1", Name: "Monthly", Type: piano_publisher.TermTypePayment, Disabled: &disabled},
+			expected: TermsDataSourceEntry{
+				TermId:   types.StringValue("TM1"),
+				Name:     types.StringValue("Monthly"),
+				Type:     types.StringValue("payment"),
+				Disabled: types.BoolValue(true),
+			},
+		},
+		{
+			name: "disabled missing",
+			term: piano_publisher.Term{TermId: "TM2", Name: "Yearly", Type: piano_publisher.TermTypePayment},
+			expected: TermsDataSourceEntry{
+				TermId:   types.StringValue("TM2"),
+				Name:     types.StringValue("Yearly"),
+				Type:     types.StringValue("payment"),
+				Disabled: types.BoolValue(false),
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := TermsDataSourceEntryFrom(c.term); actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTimeout is the timeout of an operation whose timeout is not configured in the `timeouts` attribute.
+const defaultTimeout = 5 * time.Minute
+
+const (
+	timeoutCreate = "create"
+	timeoutRead   = "read"
+	timeoutUpdate = "update"
+	timeoutDelete = "delete"
+)
+
+func timeoutsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		timeoutCreate: types.StringType,
+		timeoutRead:   types.StringType,
+		timeoutUpdate: types.StringType,
+		timeoutDelete: types.StringType,
+	}
+}
+
+// timeoutsAttribute returns the `timeouts` attribute of a resource.
+// It follows the shape of terraform-plugin-framework-timeouts so that configurations keep working if the provider moves to it.
+func timeoutsAttribute() schema.SingleNestedAttribute {
+	attributes := map[string]schema.Attribute{}
+	for operation := range timeoutsAttrTypes() {
+		attributes[operation] = schema.StringAttribute{
+			Optional:            true,
+			Validators:          []validator.String{durationValidator{}},
+			MarkdownDescription: fmt.Sprintf("The timeout of %s operation such as `30s` or `10m`(default: `%s`).", operation, defaultTimeout),
+		}
+	}
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		Attributes:          attributes,
+		MarkdownDescription: "The timeouts of the operations on this resource",
+	}
+}
+
+// timeoutsNull is the value of the `timeouts` attribute for state that does not come from a configuration.
+func timeoutsNull() types.Object {
+	return types.ObjectNull(timeoutsAttrTypes())
+}
+
+// withTimeout returns a context that is cancelled after the timeout configured for the operation, or defaultTimeout.
+func withTimeout(ctx context.Context, timeouts types.Object, operation string) (context.Context, context.CancelFunc) {
+	timeout := defaultTimeout
+	if value, ok := timeouts.Attributes()[operation].(types.String); ok && !value.IsNull() && !value.IsUnknown() {
+		if duration, err := time.ParseDuration(value.ValueString()); err == nil {
+			timeout = duration
+		}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// durationValidator validates that a string is a positive duration accepted by time.ParseDuration.
+type durationValidator struct{}
+
+func (durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 30s, 10m or 1h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && duration <= 0 {
+		err = fmt.Errorf("duration must be positive")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%s, got %q: %s", v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithTimeout(t *testing.T) {
+	timeouts := types.ObjectValueMust(timeoutsAttrTypes(), map[string]attr.Value{
+		timeoutCreate: types.StringValue("30m"),
+		timeoutRead:   types.StringNull(),
+		timeoutUpdate: types.StringNull(),
+		timeoutDelete: types.StringNull(),
+	})
+	cases := []struct {
+		name      string
+		timeouts  types.Object
+		operation string
+		expected  time.Duration
+	}{
+		{name: "configured timeout", timeouts: timeouts, operation: timeoutCreate, expected: 30 * time.Minute},
+		{name: "operation is not configured", timeouts: timeouts, operation: timeoutRead, expected: defaultTimeout},
+		{name: "timeouts is not configured", timeouts: timeoutsNull(), operation: timeoutDelete, expected: defaultTimeout},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := withTimeout(context.Background(), c.timeouts, c.operation)
+			defer cancel()
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("expected deadline to be set")
+			}
+			if remaining := time.Until(deadline); remaining > c.expected || remaining < c.expected-time.Minute {
+				t.Errorf("expected deadline in %s, got %s", c.expected, remaining)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	cases := map[string]bool{
+		"10m":   false,
+		"1h30m": false,
+		"0s":    true,
+		"-1m":   true,
+		"10":    true,
+		"ten":   true,
+	}
+	for value, expectError := range cases {
+		resp := validator.StringResponse{}
+		durationValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("timeouts").AtName(timeoutCreate),
+			ConfigValue: types.StringValue(value),
+		}, &resp)
+		if resp.Diagnostics.HasError() != expectError {
+			t.Errorf("%s: expected error: %t, got %v", value, expectError, resp.Diagnostics)
+		}
+	}
+}
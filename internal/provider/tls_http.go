@@ -0,0 +1,40 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// newTlsHttpTransport returns a copy of http.DefaultTransport with the TLS settings of the provider.
+// The certificates in caBundle are trusted in addition to the system roots so that requests through an inspecting proxy can be verified.
+// insecureSkipVerify disables the verification of server certificates altogether.
+func newTlsHttpTransport(insecureSkipVerify bool, caBundle string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify, // #nosec G402 -- enabled only by insecure_skip_verify
+	}
+	if caBundle == "" {
+		return transport, nil
+	}
+	pem, err := os.ReadFile(caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no PEM encoded certificate found in CA bundle")
+	}
+	transport.TLSClientConfig.RootCAs = pool
+	return transport, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTlsHttpTransportInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, insecureSkipVerify := range []bool{false, true} {
+		transport, err := newTlsHttpTransport(insecureSkipVerify, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if transport.TLSClientConfig.InsecureSkipVerify != insecureSkipVerify {
+			t.Errorf("expected InsecureSkipVerify to be %t, got %t", insecureSkipVerify, transport.TLSClientConfig.InsecureSkipVerify)
+		}
+		// the certificate of httptest server is self-signed
+		response, err := (&http.Client{Transport: transport}).Get(server.URL)
+		if insecureSkipVerify && err != nil {
+			t.Errorf("expected the request to succeed without verification, got %s", err)
+		}
+		if !insecureSkipVerify && err == nil {
+			t.Error("expected the request to fail the verification of the self-signed certificate")
+		}
+		if response != nil {
+			response.Body.Close()
+		}
+	}
+}
+
+func TestTlsHttpTransportCaBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	err := os.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err := newTlsHttpTransport(false, caBundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected certificates to be verified")
+	}
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the certificate in the CA bundle to be trusted, got %s", err)
+	}
+	response.Body.Close()
+}
+
+func TestTlsHttpTransportInvalidCaBundle(t *testing.T) {
+	invalid := filepath.Join(t.TempDir(), "invalid.pem")
+	if err := os.WriteFile(invalid, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, caBundle := range []string{invalid, filepath.Join(t.TempDir(), "missing.pem")} {
+		if _, err := newTlsHttpTransport(false, caBundle); err == nil {
+			t.Errorf("expected an error for %s", caBundle)
+		}
+	}
+}
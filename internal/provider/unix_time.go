@@ -0,0 +1,87 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-piano/internal/syntax"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxUnixTime is 9999-12-31T23:59:59Z in unix time.
+// A larger value is almost certainly milliseconds, which piano.io API would read as a date far in the future.
+const maxUnixTime = 253402300799
+
+// unixTimeValidator validates that an integer is a unix time in seconds rather than in milliseconds.
+type unixTimeValidator struct{}
+
+func (unixTimeValidator) Description(ctx context.Context) string {
+	return "value must be a unix time in seconds"
+}
+
+func (v unixTimeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v unixTimeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueInt64()
+	if value <= maxUnixTime {
+		return
+	}
+	detail := fmt.Sprintf("%s, got %d, which is later than year 9999.", v.Description(ctx), value)
+	if seconds := value / 1000; seconds <= maxUnixTime {
+		detail += fmt.Sprintf(" The value looks like milliseconds; use %d (%s) instead.", seconds, time.Unix(seconds, 0).UTC().Format(time.RFC3339))
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Unix Time", detail)
+}
+
+// unixTimeIsoFrom formats a unix time as RFC3339 in location for the `*_iso` attributes.
+// location is the `date_timezone` of the provider; nil is treated as UTC.
+// piano.io API returns 0 for dates that are not set, which is formatted as null as well as null and unknown values.
+func unixTimeIsoFrom(value types.Int64, location *time.Location) types.String {
+	if value.IsUnknown() {
+		return types.StringUnknown()
+	}
+	if value.IsNull() || value.ValueInt64() == 0 {
+		return types.StringNull()
+	}
+	if location == nil {
+		location = time.UTC
+	}
+	return types.StringValue(syntax.FormatDate(value.ValueInt64(), location))
+}
+
+// unixTimeIsoAttribute returns the computed `*_iso` attribute mirroring the unix time attribute described by description.
+func unixTimeIsoAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("%s in RFC3339 format in the `date_timezone` of the provider (UTC by default)", description),
+	}
+}
+
+// planUnixTimeIso plans the `{name}_iso` attribute of each unix time attribute from its planned value.
+// Without it, the `*_iso` attributes would be shown as unknown on every change even when the unix times stay the same.
+func planUnixTimeIso(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, location *time.Location, names ...string) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	for _, name := range names {
+		var value types.Int64
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name+"_iso"), unixTimeIsoFrom(value, location))...)
+	}
+}
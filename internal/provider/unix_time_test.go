@@ -0,0 +1,72 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnixTimeValidator(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    types.Int64
+		expected string
+	}{
+		{name: "seconds", value: types.Int64Value(1735657200)},
+		{name: "max unix time", value: types.Int64Value(maxUnixTime)},
+		{name: "null", value: types.Int64Null()},
+		{name: "unknown", value: types.Int64Unknown()},
+		{name: "milliseconds", value: types.Int64Value(1735657200000), expected: "use 1735657200 (2024-12-31T15:00:00Z) instead"},
+		{name: "microseconds", value: types.Int64Value(1735657200000000), expected: "later than year 9999"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := validator.Int64Response{}
+			unixTimeValidator{}.ValidateInt64(context.Background(), validator.Int64Request{
+				Path:        path.Root("start_date"),
+				ConfigValue: c.value,
+			}, &resp)
+			if c.expected == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error for %s", c.value)
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, c.expected) {
+				t.Errorf("expected %q in %q", c.expected, detail)
+			}
+		})
+	}
+}
+
+func TestUnixTimeIsoFrom(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	cases := []struct {
+		value    types.Int64
+		location *time.Location
+		expected types.String
+	}{
+		{value: types.Int64Value(1735657200), expected: types.StringValue("2024-12-31T15:00:00Z")},
+		{value: types.Int64Value(1735657200), location: time.UTC, expected: types.StringValue("2024-12-31T15:00:00Z")},
+		{value: types.Int64Value(1735657200), location: tokyo, expected: types.StringValue("2025-01-01T00:00:00+09:00")},
+		{value: types.Int64Value(0), location: tokyo, expected: types.StringNull()},
+		{value: types.Int64Null(), expected: types.StringNull()},
+		{value: types.Int64Unknown(), expected: types.StringUnknown()},
+	}
+	for _, c := range cases {
+		if actual := unixTimeIsoFrom(c.value, c.location); !actual.Equal(c.expected) {
+			t.Errorf("%s in %s: expected %s, got %s", c.value, c.location, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// userAgent returns the User-Agent sent to piano.io API so that piano.io support can tell requests from this provider.
+//
+// Both the piano publisher and piano ID clients send the same value,
+// "terraform-provider-piano/<version> (terraform-plugin-framework)", e.g. "terraform-provider-piano/1.2.3 (terraform-plugin-framework)".
+// It is a product token followed by a comment as in RFC 9110, so that the provider version is found with the product name
+// and a log of piano.io API can be filtered by the provider regardless of which client sent the request.
+// version is set by goreleaser on release and is "dev" for a local build.
+// Without it, the generated clients send the default "Go-http-client/1.1" of net/http.
+func userAgent(version string) string {
+	return fmt.Sprintf("terraform-provider-piano/%s (terraform-plugin-framework)", version)
+}
+
+// userAgentRequestEditor sets userAgent to requests. It is shared by piano publisher and piano ID clients.
+func userAgentRequestEditor(version string) func(ctx context.Context, req *http.Request) error {
+	value := userAgent(version)
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", value)
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-piano/internal/piano_id"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderClientsSendUserAgent(t *testing.T) {
+	ctx := context.Background()
+	userAgents := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		userAgents[req.URL.Path] = req.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	p := &PianoProvider{version: "1.2.3"}
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	config := newTestConfig(t, schemaResp.Schema, &PianoProviderModel{
+		Endpoint: types.StringValue(server.URL + "/api/v3"),
+		ApiToken: types.StringValue("token"),
+		AppId:    types.StringValue("example"),
+	})
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	providerData := resp.ResourceData.(*PianoProviderData)
+
+	response, err := providerData.publisherClient.GetPublisherAppGet(ctx, &piano_publisher.GetPublisherAppGetParams{Aid: "example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	response, err = providerData.idClient.PublisherUsersGet(ctx, &piano_id.PublisherUsersGetParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if len(userAgents) != 2 {
+		t.Fatalf("expected requests from both clients, got %v", userAgents)
+	}
+	expected := "terraform-provider-piano/1.2.3 (terraform-plugin-framework)"
+	for path, actual := range userAgents {
+		if actual != expected {
+			t.Errorf("%s: expected User-Agent %q, got %q", path, expected, actual)
+		}
+	}
+}
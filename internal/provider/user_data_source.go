@@ -0,0 +1,211 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-piano/internal/piano_id"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &UserDataSource{}
+	_ datasource.DataSourceWithConfigure        = &UserDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &UserDataSource{}
+)
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *piano_id.Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	Aid            types.String                `tfsdk:"aid"`             // The application ID
+	Uid            types.String                `tfsdk:"uid"`             // The user ID
+	Email          types.String                `tfsdk:"email"`           // The user email
+	FirstName      types.String                `tfsdk:"first_name"`      // The user first name
+	LastName       types.String                `tfsdk:"last_name"`       // The user last name
+	PersonalName   types.String                `tfsdk:"personal_name"`   // The user personal name
+	EmailConfirmed types.Bool                  `tfsdk:"email_confirmed"` // Whether or not the user email is confirmed
+	CreateDate     types.Int64                 `tfsdk:"create_date"`     // The user creation date
+	CustomFields   []UserCustomFieldValueModel `tfsdk:"custom_fields"`   // The custom field values of the user
+}
+
+type UserCustomFieldValueModel struct {
+	FieldName types.String `tfsdk:"field_name"` // The field name of the custom field
+	Value     types.String `tfsdk:"value"`      // The value of the custom field
+}
+
+func (*UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (*UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Piano ID user data source. This data source is used to look up a user by `uid` or `email` " +
+			"and verify that custom fields are populated as expected.",
+		Attributes: map[string]schema.Attribute{
+			"aid": schema.StringAttribute{
+				MarkdownDescription: "The application ID",
+				Required:            true,
+				Validators:          aidValidators(),
+			},
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "The user ID. Either `uid` or `email` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The user email. Either `uid` or `email` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The user first name",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The user last name",
+				Computed:            true,
+			},
+			"personal_name": schema.StringAttribute{
+				MarkdownDescription: "The user personal name",
+				Computed:            true,
+			},
+			"email_confirmed": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the user email is confirmed",
+				Computed:            true,
+			},
+			"create_date": schema.Int64Attribute{
+				MarkdownDescription: "The user creation date",
+				Computed:            true,
+			},
+			"custom_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The custom field values of the user",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							MarkdownDescription: "The field name of the custom field",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value of the custom field",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (*UserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uid"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	d.client = &client.idClient
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := fetchPublisherUser(ctx, d.client, state.Aid.ValueString(), state.Uid, state.Email, &resp.Diagnostics)
+	if data == nil {
+		return
+	}
+
+	state.Uid = types.StringValue(data.Uid)
+	state.Email = types.StringValue(data.Email)
+	state.FirstName = types.StringPointerValue(data.FirstName)
+	state.LastName = types.StringPointerValue(data.LastName)
+	state.PersonalName = types.StringPointerValue(data.PersonalName)
+	state.EmailConfirmed = types.BoolValue(data.EmailConfirmed)
+	state.CreateDate = types.Int64PointerValue(data.CreateDate)
+	customFields := []UserCustomFieldValueModel{}
+	for _, field := range data.CustomFields {
+		customFields = append(customFields, UserCustomFieldValueModel{
+			FieldName: types.StringValue(field.FieldName),
+			Value:     types.StringPointerValue(field.Value),
+		})
+	}
+	state.CustomFields = customFields
+	tflog.Trace(ctx, "read a user data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// fetchPublisherUser looks up a user by uid or email. It returns nil after reporting an error when the user is not available.
+func fetchPublisherUser(ctx context.Context, client *piano_id.Client, aid string, uid types.String, email types.String, diagnostics *diag.Diagnostics) *piano_id.PublisherUser {
+	response, err := client.PublisherUsersGet(ctx, &piano_id.PublisherUsersGetParams{
+		Aid:   aid,
+		Uid:   uid.ValueStringPointer(),
+		Email: email.ValueStringPointer(),
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch user, got error: %s", err))
+		return nil
+	}
+	result, err := piano_id.ParsePublisherUsersGetResponse(response)
+	if err != nil {
+		diagnostics.AddError("Marshal Error", fmt.Sprintf("Unable to parse response as PublisherUsersGetResponse, got error: %s", err))
+		return nil
+	}
+	notFound := func() {
+		diagnostics.AddError("User Not Found", fmt.Sprintf("No user matches uid=%q email=%q in %s", uid.ValueString(), email.ValueString(), aid))
+	}
+	if result.JSON200 == nil {
+		if result.StatusCode() == http.StatusNotFound {
+			notFound()
+			return nil
+		}
+		messages := []string{}
+		if result.JSONDefault != nil {
+			for _, message := range result.JSONDefault.ErrorCodeList {
+				messages = append(messages, message.Message)
+			}
+		}
+		diagnostics.AddError("Status Error", fmt.Sprintf("Unable to fetch user due to %s", "["+strings.Join(messages, ",")+"]"))
+		return nil
+	}
+	if result.JSON200.Uid == "" {
+		notFound()
+		return nil
+	}
+	return result.JSON200
+}
@@ -0,0 +1,140 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"terraform-provider-piano/internal/piano_id"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserDataSourceRead(t *testing.T) {
+	cases := []struct {
+		name          string
+		config        UserDataSourceModel
+		expectedQuery string
+		status        int
+		response      string
+		expected      UserDataSourceModel
+		expectedError string
+	}{
+		{
+			name:          "by uid",
+			config:        UserDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringValue("UXXXXXX"), Email: types.StringNull()},
+			expectedQuery: "aid=example&uid=UXXXXXX",
+			status:        http.StatusOK,
+			response: `{"uid":"UXXXXXX","email":"user@example.com","first_name":"Taro","last_name":null,"email_confirmed":true,"create_date":1735657200,` +
+				`"custom_fields":[{"field_name":"newsletter","value":"true"},{"field_name":"nickname","value":null}]}`,
+			expected: UserDataSourceModel{
+				Aid:            types.StringValue("example"),
+				Uid:            types.StringValue("UXXXXXX"),
+				Email:          types.StringValue("user@example.com"),
+				FirstName:      types.StringValue("Taro"),
+				LastName:       types.StringNull(),
+				PersonalName:   types.StringNull(),
+				EmailConfirmed: types.BoolValue(true),
+				CreateDate:     types.Int64Value(1735657200),
+				CustomFields: []UserCustomFieldValueModel{
+					{FieldName: types.StringValue("newsletter"), Value: types.StringValue("true")},
+					{FieldName: types.StringValue("nickname"), Value: types.StringNull()},
+				},
+			},
+		},
+		{
+			name:          "by email",
+			config:        UserDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringNull(), Email: types.StringValue("user@example.com")},
+			expectedQuery: "aid=example&email=user%40example.com",
+			status:        http.StatusOK,
+			response:      `{"uid":"UXXXXXX","email":"user@example.com","email_confirmed":false,"custom_fields":[]}`,
+			expected: UserDataSourceModel{
+				Aid:            types.StringValue("example"),
+				Uid:            types.StringValue("UXXXXXX"),
+				Email:          types.StringValue("user@example.com"),
+				FirstName:      types.StringNull(),
+				LastName:       types.StringNull(),
+				PersonalName:   types.StringNull(),
+				EmailConfirmed: types.BoolValue(false),
+				CreateDate:     types.Int64Null(),
+				CustomFields:   []UserCustomFieldValueModel{},
+			},
+		},
+		{
+			name:          "not found",
+			config:        UserDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringValue("UNKNOWN"), Email: types.StringNull()},
+			expectedQuery: "aid=example&uid=UNKNOWN",
+			status:        http.StatusNotFound,
+			response:      `{"error_code_list":[{"message":"User not found"}]}`,
+			expectedError: "User Not Found",
+		},
+		{
+			name:          "empty user",
+			config:        UserDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringNull(), Email: types.StringValue("nobody@example.com")},
+			expectedQuery: "aid=example&email=nobody%40example.com",
+			status:        http.StatusOK,
+			response:      `{"uid":"","email":"","custom_fields":[]}`,
+			expectedError: "User Not Found",
+		},
+		{
+			name:          "error",
+			config:        UserDataSourceModel{Aid: types.StringValue("example"), Uid: types.StringValue("UXXXXXX"), Email: types.StringNull()},
+			expectedQuery: "aid=example&uid=UXXXXXX",
+			status:        http.StatusForbidden,
+			response:      `{"error_code_list":[{"message":"Access denied"}]}`,
+			expectedError: "Status Error",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/publisher/users/get" {
+					t.Errorf("unexpected request: %s", req.URL)
+				}
+				if req.URL.RawQuery != c.expectedQuery {
+					t.Errorf("expected query %s, got %s", c.expectedQuery, req.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, c.response)
+			}))
+			defer server.Close()
+			client, err := piano_id.NewClient(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &UserDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			config := newTestConfig(t, schemaResp.Schema, &c.config)
+
+			resp := datasource.ReadResponse{State: newTestState(t, schemaResp.Schema, nil)}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+			if c.expectedError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != c.expectedError {
+					t.Fatalf("expected %s, got %v", c.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var actual UserDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &actual)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
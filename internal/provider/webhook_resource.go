@@ -0,0 +1,269 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-piano/internal/piano_publisher"
+	"terraform-provider-piano/internal/syntax"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &WebhookResource{}
+	_ resource.ResourceWithModifyPlan  = &WebhookResource{}
+	_ resource.ResourceWithImportState = &WebhookResource{}
+)
+
+type WebhookResource struct {
+	client     piano_publisher.ClientInterface
+	defaultAid types.String
+}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := configureClients(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if client == nil {
+		return
+	}
+
+	r.client = &client.publisherClient
+	r.defaultAid = client.defaultAid
+}
+
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultAid(ctx, r.defaultAid, req, resp)
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+type WebhookResourceModel struct {
+	Aid        types.String   `tfsdk:"aid"`         // The application ID
+	Url        types.String   `tfsdk:"url"`         // The webhook endpoint URL
+	Enabled    types.Bool     `tfsdk:"enabled"`     // Whether the webhook endpoint is enabled
+	EventTypes []types.String `tfsdk:"event_types"` // The webhook config keys to subscribe
+}
+
+func (*WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Webhook resource. Webhooks notify an endpoint of events such as new purchases or access changes. " +
+			"piano.io manages a single webhook endpoint per application, so this resource is identified by `aid`.\n\n" +
+			"piano.io API provides no endpoint to delete the webhook settings, so destroying this resource disables the webhook endpoint " +
+			"and unsubscribes all the event types while the URL is left as is.\n\n" +
+			"The application private key to decrypt webhook payloads is not exposed by this resource as piano.io API does not return it; " +
+			"get it from piano.io dashboard. For more details, see https://docs.piano.io/webhooks/",
+		Attributes: map[string]schema.Attribute{
+			"aid": defaultAidAttribute(),
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The webhook endpoint URL",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the webhook endpoint is enabled",
+			},
+			"event_types": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The webhook event types (webhook config keys such as `new_purchase` or `access_revoked`) to subscribe",
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("configuring webhook %s in %s", state.Url.ValueString(), state.Aid.ValueString()))
+	r.update(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	settings := r.settings(ctx, state.Aid.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Url = types.StringValue(settings.Url)
+	state.Enabled = types.BoolValue(settings.Enabled)
+	state.EventTypes = WebhookEventTypesFrom(settings.Configs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("updating webhook %s in %s", state.Url.ValueString(), state.Aid.ValueString()))
+	r.update(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// piano.io API provides no endpoint to delete the webhook settings of an application.
+	// The webhook is disabled and unsubscribed from all the event types instead so that no events are sent.
+	// syntax.DeletedResponseFrom does not apply as the settings of an application always exist and the update must succeed.
+	tflog.Info(ctx, fmt.Sprintf("disabling webhook %s in %s", state.Url.ValueString(), state.Aid.ValueString()))
+	state.Enabled = types.BoolValue(false)
+	state.EventTypes = []types.String{}
+	r.update(ctx, &state, &resp.Diagnostics)
+}
+
+// ImportState imports the webhook settings of an application by `{aid}`.
+// The settings are a singleton of each application without an ID of their own;
+// webhook_id of piano.io API identifies a delivered webhook event, not the settings.
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" || strings.Contains(req.ID, "/") {
+		resp.Diagnostics.AddError("Invalid webhook resource id", "webhook resource id must be in {aid} format as piano.io manages a single webhook per application. "+
+			"{aid}/{webhook_id} is not supported as webhook_id identifies a delivered webhook event, not the webhook settings.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("aid"), req.ID)...)
+}
+
+// update replaces webhook settings with state and reconciles the subscribed event types with the response.
+func (r *WebhookResource) update(ctx context.Context, state *WebhookResourceModel, diagnostics *diag.Diagnostics) {
+	current := r.settings(ctx, state.Aid.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	eventTypes := []string{}
+	for _, eventType := range state.EventTypes {
+		eventTypes = append(eventTypes, eventType.ValueString())
+	}
+	configs, unknown := WebhookConfigsFrom(current.Configs, eventTypes)
+	if len(unknown) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("event_types"),
+			"Unknown webhook event types",
+			fmt.Sprintf("piano.io does not provide webhook event types [%s] for %s", strings.Join(unknown, ","), state.Aid.ValueString()),
+		)
+		return
+	}
+	serialized, err := json.Marshal(configs)
+	if err != nil {
+		diagnostics.AddError("Encode Error", fmt.Sprintf("Unable to encode webhook configs, got error: %s", err))
+		return
+	}
+	configsAsString := string(serialized)
+	response, err := r.client.PostPublisherWebhookSettingsUpdateWithFormdataBody(ctx, piano_publisher.PostPublisherWebhookSettingsUpdateFormdataRequestBody{
+		Aid:     state.Aid.ValueString(),
+		Url:     state.Url.ValueStringPointer(),
+		Enabled: state.Enabled.ValueBoolPointer(),
+		Configs: &configsAsString,
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook settings, got error: %s", err))
+		return
+	}
+	_, err = syntax.SuccessfulResponseFrom(response, diagnostics)
+	if err != nil {
+		return
+	}
+	updated := r.settings(ctx, state.Aid.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	state.Url = types.StringValue(updated.Url)
+	state.Enabled = types.BoolValue(updated.Enabled)
+	state.EventTypes = WebhookEventTypesFrom(updated.Configs)
+}
+
+func (r *WebhookResource) settings(ctx context.Context, aid string, diagnostics *diag.Diagnostics) *piano_publisher.WebhookSettings {
+	response, err := r.client.GetPublisherWebhookSettings(ctx, &piano_publisher.GetPublisherWebhookSettingsParams{
+		Aid: &aid,
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch webhook settings, got error: %s", err))
+		return nil
+	}
+	anyResponse, err := syntax.SuccessfulResponseFrom(response, diagnostics)
+	if err != nil {
+		return nil
+	}
+	result := piano_publisher.WebhookSettingsResult{}
+	err = json.Unmarshal(anyResponse.Raw, &result)
+	if err != nil {
+		diagnostics.AddError("Decode Error", fmt.Sprintf("Unable to decode piano AnyMessage into OK Result, got error: %s", err.Error()))
+		return nil
+	}
+	return &result.WebhookSettings
+}
+
+// WebhookEventTypesFrom returns the sorted keys of enabled webhook configs.
+func WebhookEventTypesFrom(configs []piano_publisher.WebhookConfig) []types.String {
+	keys := []string{}
+	for _, config := range configs {
+		if config.Enabled {
+			keys = append(keys, string(config.Key))
+		}
+	}
+	sort.Strings(keys)
+	ret := []types.String{}
+	for _, key := range keys {
+		ret = append(ret, types.StringValue(key))
+	}
+	return ret
+}
+
+// WebhookConfigsFrom enables configs in eventTypes and disables the others.
+// It also returns event types that are not available in current configs.
+func WebhookConfigsFrom(current []piano_publisher.WebhookConfig, eventTypes []string) ([]piano_publisher.WebhookConfig, []string) {
+	configs := []piano_publisher.WebhookConfig{}
+	for _, config := range current {
+		config.Enabled = slices.Contains(eventTypes, string(config.Key))
+		configs = append(configs, config)
+	}
+	unknown := []string{}
+	for _, eventType := range eventTypes {
+		known := slices.ContainsFunc(current, func(config piano_publisher.WebhookConfig) bool {
+			return string(config.Key) == eventType
+		})
+		if !known {
+			unknown = append(unknown, eventType)
+		}
+	}
+	return configs, unknown
+}
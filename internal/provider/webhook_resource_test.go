@@ -0,0 +1,175 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"terraform-provider-piano/internal/piano_publisher"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newWebhookServer serves the webhook settings of application "example" kept in settings.
+func newWebhookServer(t *testing.T, settings *piano_publisher.WebhookSettings) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/publisher/webhook/settings":
+			if err := json.NewEncoder(w).Encode(map[string]any{"code": 0, "webhook_settings": settings}); err != nil {
+				t.Error(err)
+			}
+		case "/publisher/webhook/settings/update":
+			if err := req.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			if actual := req.PostForm.Get("aid"); actual != "example" {
+				t.Errorf("expected aid example, got %s", actual)
+			}
+			settings.Url = req.PostForm.Get("url")
+			settings.Enabled = req.PostForm.Get("enabled") == "true"
+			if err := json.Unmarshal([]byte(req.PostForm.Get("configs")), &settings.Configs); err != nil {
+				t.Errorf("unexpected configs: %s", err)
+			}
+			if err := json.NewEncoder(w).Encode(map[string]any{"code": 0}); err != nil {
+				t.Error(err)
+			}
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+		}
+	}))
+}
+
+func TestWebhookResourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	settings := &piano_publisher.WebhookSettings{
+		Url: "https://old.example.com",
+		Configs: []piano_publisher.WebhookConfig{
+			{Key: "access_revoked", Enabled: true},
+			{Key: "new_purchase"},
+			{Key: "subscription_canceled"},
+		},
+	}
+	server := newWebhookServer(t, settings)
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &WebhookResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	planned := WebhookResourceModel{
+		Aid:        types.StringValue("example"),
+		Url:        types.StringValue("https://example.com/piano/webhook"),
+		Enabled:    types.BoolValue(true),
+		EventTypes: []types.String{types.StringValue("subscription_canceled"), types.StringValue("new_purchase")},
+	}
+	plan := newTestPlan(t, schemaResp.Schema, &planned)
+
+	createResp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+	if settings.Url != "https://example.com/piano/webhook" || !settings.Enabled {
+		t.Errorf("expected the webhook to be enabled with the url, got %v", settings)
+	}
+	if actual := WebhookEventTypesFrom(settings.Configs); !reflect.DeepEqual(actual, []types.String{types.StringValue("new_purchase"), types.StringValue("subscription_canceled")}) {
+		t.Errorf("expected the event types to replace the subscribed ones, got %v", actual)
+	}
+
+	// A change outside terraform is read back.
+	settings.Configs[0].Enabled = true
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	var read WebhookResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &read)...)
+	if len(read.EventTypes) != 3 {
+		t.Errorf("expected the event types subscribed outside terraform, got %v", read.EventTypes)
+	}
+
+	planned.EventTypes = []types.String{types.StringValue("new_purchase")}
+	plan = newTestPlan(t, schemaResp.Schema, &planned)
+	updateResp := resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: readResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	var updated WebhookResourceModel
+	updateResp.Diagnostics.Append(updateResp.State.Get(ctx, &updated)...)
+	if !reflect.DeepEqual(updated.EventTypes, []types.String{types.StringValue("new_purchase")}) {
+		t.Errorf("expected the event types to be reconciled, got %v", updated.EventTypes)
+	}
+
+	deleteResp := resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", deleteResp.Diagnostics)
+	}
+	// The webhook settings cannot be deleted, so they are disabled with no event types instead.
+	if settings.Enabled || len(WebhookEventTypesFrom(settings.Configs)) != 0 {
+		t.Errorf("expected the webhook to be disabled without event types, got %v", settings)
+	}
+}
+
+func TestWebhookResourceUnknownEventTypes(t *testing.T) {
+	ctx := context.Background()
+	settings := &piano_publisher.WebhookSettings{Configs: []piano_publisher.WebhookConfig{{Key: "new_purchase"}}}
+	server := newWebhookServer(t, settings)
+	defer server.Close()
+	client, err := piano_publisher.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &WebhookResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := newTestPlan(t, schemaResp.Schema, &WebhookResourceModel{
+		Aid:        types.StringValue("example"),
+		Url:        types.StringValue("https://example.com/piano/webhook"),
+		Enabled:    types.BoolValue(true),
+		EventTypes: []types.String{types.StringValue("new_purchase"), types.StringValue("no_such_event")},
+	})
+	resp := resource.CreateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unknown webhook event types" {
+		t.Fatalf("expected an error for the unknown event type, got %v", resp.Diagnostics)
+	}
+	if settings.Url != "" {
+		t.Errorf("expected the webhook settings not to be updated, got %v", settings)
+	}
+}
+
+func TestWebhookResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &WebhookResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	cases := []struct {
+		id            string
+		expectedError bool
+	}{
+		{id: "example"},
+		{id: "example/WH1", expectedError: true},
+		{id: "", expectedError: true},
+	}
+	for _, c := range cases {
+		resp := resource.ImportStateResponse{State: newTestState(t, schemaResp.Schema, nil)}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: c.id}, &resp)
+		if resp.Diagnostics.HasError() != c.expectedError {
+			t.Errorf("%q: expected error: %t, got %v", c.id, c.expectedError, resp.Diagnostics)
+		}
+	}
+}
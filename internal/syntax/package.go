@@ -4,14 +4,220 @@
 package syntax
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"terraform-provider-piano/internal/piano"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// APIError is an unsuccessful response of piano.io API. See piano.APIError.
+type APIError = piano.APIError
+
+var (
+	// ErrNotFound matches an *APIError reporting that the requested object does not exist.
+	ErrNotFound = piano.ErrNotFound
+	// ErrRateLimited matches an *APIError reporting that piano.io API throttled the request.
+	ErrRateLimited = piano.ErrRateLimited
+)
+
+// SuccessfulResponseFrom decodes a piano.io response that is expected to succeed.
+// A response other than HTTP status 200 with code 0 is reported as an error diagnostic and returned as *APIError.
+// Use it unless the caller handles specific error codes.
 func SuccessfulResponseFrom(response *http.Response, diagnostics *diag.Diagnostics) (*piano.AnyResponse, error) {
 	return piano.SuccessfulResponseFrom(response, func(summary, detail string) {
 		diagnostics.AddError(summary, detail)
 	})
 }
+
+// AnyResponseFrom decodes a piano.io response without checking its code.
+// Use it only when the caller handles specific error codes, e.g. with errors.Is(anyResponse.Err(), ErrNotFound),
+// and reports the others with AddStatusError.
+func AnyResponseFrom(response *http.Response, diagnostics *diag.Diagnostics) (*piano.AnyResponse, error) {
+	return piano.AnyResponseFrom(response, func(summary, detail string) {
+		diagnostics.AddError(summary, detail)
+	})
+}
+
+// AddStatusError reports the code and message of an unsuccessful response decoded by AnyResponseFrom.
+func AddStatusError(anyResponse *piano.AnyResponse, diagnostics *diag.Diagnostics) {
+	diagnostics.AddError(anyResponse.StatusErrorSummary(), string(anyResponse.Raw))
+}
+
+// DeletedResponseFrom decodes a piano.io response to a request deleting an object.
+//
+// A response reporting that the object does not exist is treated as successful,
+// so that destroying an object already deleted outside terraform does not fail.
+// The other unsuccessful responses are reported as error diagnostics and returned as *APIError.
+func DeletedResponseFrom(ctx context.Context, response *http.Response, diagnostics *diag.Diagnostics) error {
+	anyResponse, err := AnyResponseFrom(response, diagnostics)
+	if err != nil {
+		return err
+	}
+	err = anyResponse.Err()
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "the object has already been deleted", map[string]any{"error": err.Error()})
+		return nil
+	}
+	if err != nil {
+		AddStatusError(anyResponse, diagnostics)
+		return err
+	}
+	return nil
+}
+
+// RequireIdentifier reports an error diagnostic when an identifier decoded from a successful response is empty.
+//
+// A response of an unexpected shape, such as an error envelope with code 0, decodes without an error but leaves the object empty.
+// The identifier is checked so that such a response does not overwrite terraform state with empty values.
+// It returns false when the identifier is empty.
+func RequireIdentifier(anyResponse *piano.AnyResponse, name string, value string, diagnostics *diag.Diagnostics) bool {
+	if value != "" {
+		return true
+	}
+	diagnostics.AddError("Unexpected Response", fmt.Sprintf("piano.io API returned a response without %s: %s", name, string(anyResponse.Raw)))
+	return false
+}
+
+// PageSize is the number of items Paginate requests per page.
+const PageSize = 100
+
+// Paginate fetches all the items of a piano.io list endpoint page by page with the offset and limit parameters.
+//
+// fetchPage returns the items of the page and the total number of items reported by the endpoint, or a negative number when it is unknown.
+// It is expected to report its own errors as diagnostics as Paginate only returns the error as is.
+// Pagination stops at a page shorter than the limit or when the total number of items has been fetched.
+func Paginate[T any](ctx context.Context, fetchPage func(offset, limit int) ([]T, int, error)) ([]T, error) {
+	items := []T{}
+	offset := 0
+	for {
+		tflog.Trace(ctx, "fetching a page", map[string]any{"offset": offset, "limit": PageSize})
+		page, total, err := fetchPage(offset, PageSize)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		offset += len(page)
+		if len(page) < PageSize || (total >= 0 && offset >= total) {
+			return items, nil
+		}
+	}
+}
+
+// TotalFrom returns the total number of items reported by a list endpoint, or -1 when the response does not report it.
+func TotalFrom(anyResponse *piano.AnyResponse) int {
+	if anyResponse.Total == nil {
+		return -1
+	}
+	return *anyResponse.Total
+}
+
+// EmptyStringMode decides how ReconcileOptionalStringWithMode treats an empty or missing string returned from piano.io API.
+//
+// In both modes, an empty string is kept as null while the attribute is not set in the configuration, i.e. null in the plan,
+// as terraform requires the applied value to match the planned null.
+type EmptyStringMode int
+
+const (
+	// EmptyStringAsUnset treats an empty string as the attribute being unset.
+	// A missing value is null even when the attribute is set to an empty string.
+	EmptyStringAsUnset EmptyStringMode = iota
+	// EmptyStringAsValue treats an empty string set in the configuration as a meaningful value.
+	// It is kept as an empty string even when piano.io API returns null for the value, e.g. to clear a description.
+	EmptyStringAsValue
+)
+
+// ReconcileOptionalString converts an optional string returned from piano.io API into terraform value.
+// It is ReconcileOptionalStringWithMode with EmptyStringAsUnset.
+//
+// piano.io API returns an empty string for optional string fields that have never been set.
+// When user leaves the attribute null, the empty string is kept as null to avoid perpetual diffs.
+func ReconcileOptionalString(plan types.String, apiValue *string) types.String {
+	return ReconcileOptionalStringWithMode(plan, apiValue, EmptyStringAsUnset)
+}
+
+// ReconcileOptionalStringWithMode converts an optional string returned from piano.io API into terraform value.
+// Whether the attribute is set is decided by the null-ness of the plan, so an empty string in the configuration
+// is distinguished from the attribute left unset. See EmptyStringMode for the handling of empty strings.
+func ReconcileOptionalStringWithMode(plan types.String, apiValue *string, mode EmptyStringMode) types.String {
+	if plan.IsNull() && apiValue != nil && *apiValue == "" {
+		return types.StringNull()
+	}
+	if mode == EmptyStringAsValue && apiValue == nil && plan.Equal(types.StringValue("")) {
+		return types.StringValue("")
+	}
+	return types.StringPointerValue(apiValue)
+}
+
+// ReconcileOptionalBool converts an optional boolean returned from piano.io API into terraform value.
+//
+// piano.io API returns false or omits optional boolean fields that have never been set.
+// When user leaves the attribute null, such a value is kept as null to avoid perpetual diffs.
+func ReconcileOptionalBool(plan types.Bool, apiValue *bool) types.Bool {
+	if plan.IsNull() && (apiValue == nil || !*apiValue) {
+		return types.BoolNull()
+	}
+	return types.BoolPointerValue(apiValue)
+}
+
+// ReconcileOptionalInt32 converts an optional integer returned from piano.io API into terraform value.
+//
+// piano.io API returns 0 or omits optional integer fields that have never been set.
+// When user leaves the attribute null, such a value is kept as null to avoid perpetual diffs.
+func ReconcileOptionalInt32(plan types.Int32, apiValue *int32) types.Int32 {
+	if plan.IsNull() && (apiValue == nil || *apiValue == 0) {
+		return types.Int32Null()
+	}
+	return types.Int32PointerValue(apiValue)
+}
+
+// ChangedString returns the planned string only when it differs from the prior state.
+//
+// piano.io API keeps fields omitted from an update request as they are,
+// so unchanged fields are left out so as not to overwrite changes made outside terraform.
+// Unknown values are left out as well and populated from the API response.
+// A field removed from the configuration, i.e. set in the prior state but null in the plan,
+// cannot be left out as piano.io would keep the old value, so it is cleared with an empty string.
+func ChangedString(plan types.String, state types.String) *string {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+	if plan.IsNull() {
+		return new(string)
+	}
+	return plan.ValueStringPointer()
+}
+
+// ChangedBool returns the planned boolean only when it differs from the prior state.
+// A removed field is cleared with false. See ChangedString.
+func ChangedBool(plan types.Bool, state types.Bool) *bool {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+	if plan.IsNull() {
+		return new(bool)
+	}
+	return plan.ValueBoolPointer()
+}
+
+// ChangedInt32 returns the planned integer only when it differs from the prior state.
+// A removed field is cleared with 0. See ChangedString.
+func ChangedInt32(plan types.Int32, state types.Int32) *int32 {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+	if plan.IsNull() {
+		return new(int32)
+	}
+	return plan.ValueInt32Pointer()
+}
+
+// FormatDate formats a timestamp returned from piano.io API as RFC3339 date in the given location.
+func FormatDate(timestamp int64, location *time.Location) string {
+	return time.Unix(timestamp, 0).In(location).Format(time.RFC3339)
+}
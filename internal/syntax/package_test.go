@@ -0,0 +1,470 @@
+// Copyright (c) Yoichiro Ito <contact.110416@gmail.com>
+// SPDX-License-Identifier: MPL-2.0
+
+package syntax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestReconcileOptionalString(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     types.String
+		apiValue *string
+		expected types.String
+	}{
+		{name: "null plan and empty api value", plan: types.StringNull(), apiValue: ptr(""), expected: types.StringNull()},
+		{name: "null plan and nil api value", plan: types.StringNull(), apiValue: nil, expected: types.StringNull()},
+		{name: "null plan and set api value", plan: types.StringNull(), apiValue: ptr("value"), expected: types.StringValue("value")},
+		{name: "set plan and empty api value", plan: types.StringValue(""), apiValue: ptr(""), expected: types.StringValue("")},
+		{name: "set plan and set api value", plan: types.StringValue("value"), apiValue: ptr("value"), expected: types.StringValue("value")},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ReconcileOptionalString(c.plan, c.apiValue)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReconcileOptionalStringWithMode(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     types.String
+		apiValue *string
+		mode     EmptyStringMode
+		expected types.String
+	}{
+		{name: "unset: null plan and empty api value", plan: types.StringNull(), apiValue: ptr(""), mode: EmptyStringAsUnset, expected: types.StringNull()},
+		{name: "unset: empty plan and nil api value", plan: types.StringValue(""), apiValue: nil, mode: EmptyStringAsUnset, expected: types.StringNull()},
+		{name: "unset: empty plan and empty api value", plan: types.StringValue(""), apiValue: ptr(""), mode: EmptyStringAsUnset, expected: types.StringValue("")},
+		{name: "value: null plan and empty api value", plan: types.StringNull(), apiValue: ptr(""), mode: EmptyStringAsValue, expected: types.StringNull()},
+		{name: "value: null plan and nil api value", plan: types.StringNull(), apiValue: nil, mode: EmptyStringAsValue, expected: types.StringNull()},
+		{name: "value: empty plan and nil api value", plan: types.StringValue(""), apiValue: nil, mode: EmptyStringAsValue, expected: types.StringValue("")},
+		{name: "value: unknown plan and nil api value", plan: types.StringUnknown(), apiValue: nil, mode: EmptyStringAsValue, expected: types.StringNull()},
+		{name: "value: set plan and set api value", plan: types.StringValue("value"), apiValue: ptr("value"), mode: EmptyStringAsValue, expected: types.StringValue("value")},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ReconcileOptionalStringWithMode(c.plan, c.apiValue, c.mode)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReconcileOptionalBool(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     types.Bool
+		apiValue *bool
+		expected types.Bool
+	}{
+		{name: "null plan and nil api value", plan: types.BoolNull(), apiValue: nil, expected: types.BoolNull()},
+		{name: "null plan and false api value", plan: types.BoolNull(), apiValue: ptr(false), expected: types.BoolNull()},
+		{name: "null plan and true api value", plan: types.BoolNull(), apiValue: ptr(true), expected: types.BoolValue(true)},
+		{name: "false plan and false api value", plan: types.BoolValue(false), apiValue: ptr(false), expected: types.BoolValue(false)},
+		{name: "true plan and true api value", plan: types.BoolValue(true), apiValue: ptr(true), expected: types.BoolValue(true)},
+		{name: "true plan and nil api value", plan: types.BoolValue(true), apiValue: nil, expected: types.BoolNull()},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ReconcileOptionalBool(c.plan, c.apiValue)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReconcileOptionalInt32(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     types.Int32
+		apiValue *int32
+		expected types.Int32
+	}{
+		{name: "null plan and nil api value", plan: types.Int32Null(), apiValue: nil, expected: types.Int32Null()},
+		{name: "null plan and zero api value", plan: types.Int32Null(), apiValue: ptr(int32(0)), expected: types.Int32Null()},
+		{name: "null plan and set api value", plan: types.Int32Null(), apiValue: ptr(int32(3)), expected: types.Int32Value(3)},
+		{name: "zero plan and zero api value", plan: types.Int32Value(0), apiValue: ptr(int32(0)), expected: types.Int32Value(0)},
+		{name: "set plan and set api value", plan: types.Int32Value(3), apiValue: ptr(int32(3)), expected: types.Int32Value(3)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ReconcileOptionalInt32(c.plan, c.apiValue)
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestChangedString(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     types.String
+		state    types.String
+		expected *string
+	}{
+		{name: "unchanged value", plan: types.StringValue("a"), state: types.StringValue("a"), expected: nil},
+		{name: "unchanged null", plan: types.StringNull(), state: types.StringNull(), expected: nil},
+		{name: "changed value", plan: types.StringValue("b"), state: types.StringValue("a"), expected: ptr("b")},
+		{name: "changed to empty", plan: types.StringValue(""), state: types.StringValue("a"), expected: ptr("")},
+		{name: "removed value", plan: types.StringNull(), state: types.StringValue("a"), expected: ptr("")},
+		{name: "unknown value", plan: types.StringUnknown(), state: types.StringValue("a"), expected: nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ChangedString(c.plan, c.state)
+			if (actual == nil) != (c.expected == nil) || (actual != nil && *actual != *c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+	if actual := ChangedBool(types.BoolValue(false), types.BoolValue(true)); actual == nil || *actual {
+		t.Errorf("expected changed bool to be sent, got %v", actual)
+	}
+	if actual := ChangedInt32(types.Int32Value(3), types.Int32Value(3)); actual != nil {
+		t.Errorf("expected unchanged int32 to be omitted, got %v", *actual)
+	}
+	if actual := ChangedBool(types.BoolNull(), types.BoolValue(true)); actual == nil || *actual {
+		t.Errorf("expected removed bool to be cleared with false, got %v", actual)
+	}
+	if actual := ChangedInt32(types.Int32Null(), types.Int32Value(3)); actual == nil || *actual != 0 {
+		t.Errorf("expected removed int32 to be cleared with 0, got %v", actual)
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name     string
+		location *time.Location
+		expected string
+	}{
+		{name: "utc", location: time.UTC, expected: "2024-12-31T15:00:00Z"},
+		{name: "asia/tokyo", location: tokyo, expected: "2025-01-01T00:00:00+09:00"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := FormatDate(1735657200, c.location); actual != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSuccessfulResponseFromNonJSONResponse(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{name: "html error page", contentType: "text/html; charset=utf-8", body: "<html><body><h1>502 Bad Gateway</h1></body></html>"},
+		{name: "html without content type", contentType: "", body: "\n<!DOCTYPE html><html></html>"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			response := &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Header:     http.Header{"Content-Type": []string{c.contentType}},
+				Body:       io.NopCloser(strings.NewReader(c.body)),
+			}
+			diagnostics := diag.Diagnostics{}
+			_, err := SuccessfulResponseFrom(response, &diagnostics)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected an error diagnostic, got %v", diagnostics)
+			}
+			actual := diagnostics.Errors()[0]
+			if actual.Summary() != "Upstream Error" || !strings.Contains(actual.Detail(), "upstream returned non-JSON response (HTTP status 502)") {
+				t.Errorf("unexpected diagnostic: %s: %s", actual.Summary(), actual.Detail())
+			}
+		})
+	}
+}
+
+func TestSuccessfulResponseFromJSONResponse(t *testing.T) {
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json;charset=UTF-8"}},
+		Body:       io.NopCloser(strings.NewReader(`{"code":0,"term":{"term_id":"TMXXXXXX"}}`)),
+	}
+	diagnostics := diag.Diagnostics{}
+	anyResponse, err := SuccessfulResponseFrom(response, &diagnostics)
+	if err != nil || diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s %v", err, diagnostics)
+	}
+	if anyResponse.Code != 0 {
+		t.Errorf("expected code 0, got %d", anyResponse.Code)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	items := func(n int) []int {
+		ret := []int{}
+		for i := 0; i < n; i++ {
+			ret = append(ret, i)
+		}
+		return ret
+	}
+	cases := []struct {
+		name            string
+		items           []int
+		reportTotal     bool
+		expectedOffsets []int
+	}{
+		{name: "empty", items: items(0), expectedOffsets: []int{0}},
+		{name: "single page", items: items(3), expectedOffsets: []int{0}},
+		{name: "exactly one full page", items: items(PageSize), expectedOffsets: []int{0, PageSize}},
+		{name: "exactly one full page with total", items: items(PageSize), reportTotal: true, expectedOffsets: []int{0}},
+		{name: "multiple pages", items: items(PageSize*2 + 1), expectedOffsets: []int{0, PageSize, PageSize * 2}},
+		{name: "multiple pages with total", items: items(PageSize*2 + 1), reportTotal: true, expectedOffsets: []int{0, PageSize, PageSize * 2}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			offsets := []int{}
+			actual, err := Paginate(context.Background(), func(offset, limit int) ([]int, int, error) {
+				offsets = append(offsets, offset)
+				total := -1
+				if c.reportTotal {
+					total = len(c.items)
+				}
+				return c.items[min(offset, len(c.items)):min(offset+limit, len(c.items))], total, nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(actual, c.items) {
+				t.Errorf("expected %d items, got %d", len(c.items), len(actual))
+			}
+			if !reflect.DeepEqual(offsets, c.expectedOffsets) {
+				t.Errorf("expected pages at offsets %v, got %v", c.expectedOffsets, offsets)
+			}
+		})
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	expected := errors.New("status error")
+	calls := 0
+	_, err := Paginate(context.Background(), func(offset, limit int) ([]int, int, error) {
+		calls++
+		if offset > 0 {
+			return nil, 0, expected
+		}
+		return make([]int, limit), -1, nil
+	})
+	if !errors.Is(err, expected) {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+	if calls != 2 {
+		t.Errorf("expected pagination to stop at the failed page, got %d calls", calls)
+	}
+}
+
+func TestSuccessfulResponseFromUnsuccessfulResponse(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		body       string
+		summary    string
+	}{
+		{name: "non-zero code", statusCode: http.StatusOK, body: `{"code":2,"message":"Access denied"}`, summary: "Status Error: 2: Access denied"},
+		{name: "non-zero code without message", statusCode: http.StatusOK, body: `{"code":1001}`, summary: "Status Error: 1001: "},
+		{name: "non-200 status", statusCode: http.StatusInternalServerError, body: `{"code":0}`, summary: "Status Error: HTTP 500"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			response := &http.Response{
+				StatusCode: c.statusCode,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(c.body)),
+			}
+			diagnostics := diag.Diagnostics{}
+			_, err := SuccessfulResponseFrom(response, &diagnostics)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected an error diagnostic, got %v", diagnostics)
+			}
+			if actual := diagnostics.Errors()[0]; actual.Summary() != c.summary || actual.Detail() != c.body {
+				t.Errorf("unexpected diagnostic: %s: %s", actual.Summary(), actual.Detail())
+			}
+		})
+	}
+}
+
+func TestRequireIdentifier(t *testing.T) {
+	body := `{"code":0,"terms":[{"term_id":"TMXXXXXX"}]}`
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	diagnostics := diag.Diagnostics{}
+	anyResponse, err := SuccessfulResponseFrom(response, &diagnostics)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !RequireIdentifier(anyResponse, "term_id", "TMXXXXXX", &diagnostics) || diagnostics.HasError() {
+		t.Errorf("expected non-empty identifier to be accepted, got %v", diagnostics)
+	}
+	if RequireIdentifier(anyResponse, "term_id", "", &diagnostics) {
+		t.Error("expected empty identifier to be rejected")
+	}
+	if diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected an error diagnostic, got %v", diagnostics)
+	}
+	if actual := diagnostics.Errors()[0].Detail(); !strings.Contains(actual, "term_id") || !strings.Contains(actual, body) {
+		t.Errorf("expected the diagnostic to include the identifier name and the raw body, got %s", actual)
+	}
+}
+
+func TestAnyResponseFromToleratesNonZeroCode(t *testing.T) {
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"code":1001,"message":"Term not found"}`)),
+	}
+	diagnostics := diag.Diagnostics{}
+	anyResponse, err := AnyResponseFrom(response, &diagnostics)
+	if err != nil || diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s %v", err, diagnostics)
+	}
+	if anyResponse.Code != 1001 {
+		t.Errorf("expected code 1001, got %d", anyResponse.Code)
+	}
+	AddStatusError(anyResponse, &diagnostics)
+	if actual := diagnostics.Errors()[0]; actual.Summary() != "Status Error: 1001: Term not found" {
+		t.Errorf("unexpected diagnostic: %s", actual.Summary())
+	}
+}
+
+func TestAnyResponseFromNonJSONResponse(t *testing.T) {
+	response := &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{"Content-Type": []string{"text/html"}},
+		Body:       io.NopCloser(strings.NewReader("<html>Service Unavailable</html>")),
+	}
+	diagnostics := diag.Diagnostics{}
+	_, err := AnyResponseFrom(response, &diagnostics)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if actual := diagnostics.Errors()[0]; actual.Summary() != "Upstream Error" {
+		t.Errorf("unexpected diagnostic: %s: %s", actual.Summary(), actual.Detail())
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	cases := []struct {
+		name                string
+		statusCode          int
+		body                string
+		expectedNotFound    bool
+		expectedRateLimited bool
+		expectedCode        int
+	}{
+		{name: "term not found", statusCode: http.StatusOK, body: `{"code":1001,"message":"Term not found"}`, expectedNotFound: true, expectedCode: 1001},
+		{name: "not found code", statusCode: http.StatusOK, body: `{"code":404,"message":"Not found"}`, expectedNotFound: true, expectedCode: 404},
+		{name: "not found status", statusCode: http.StatusNotFound, body: `{"code":0}`, expectedNotFound: true},
+		{name: "rate limited", statusCode: http.StatusTooManyRequests, body: `{"code":0}`, expectedRateLimited: true},
+		{name: "code 429 is not rate limit", statusCode: http.StatusOK, body: `{"code":429,"message":"Term name is empty"}`, expectedCode: 429},
+		{name: "claimed codes", statusCode: http.StatusOK, body: `{"code":3009,"message":"Can not delete promotion with claimed codes"}`, expectedCode: 3009},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			response := &http.Response{
+				StatusCode: c.statusCode,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(c.body)),
+			}
+			diagnostics := diag.Diagnostics{}
+			_, err := SuccessfulResponseFrom(response, &diagnostics)
+			if errors.Is(err, ErrNotFound) != c.expectedNotFound {
+				t.Errorf("expected errors.Is(err, ErrNotFound) to be %t, got %s", c.expectedNotFound, err)
+			}
+			if errors.Is(err, ErrRateLimited) != c.expectedRateLimited {
+				t.Errorf("expected errors.Is(err, ErrRateLimited) to be %t, got %s", c.expectedRateLimited, err)
+			}
+			var apiError *APIError
+			if !errors.As(fmt.Errorf("wrapped: %w", err), &apiError) {
+				t.Fatalf("expected *APIError, got %T", err)
+			}
+			if apiError.StatusCode != c.statusCode || apiError.Code != c.expectedCode {
+				t.Errorf("unexpected error: %s", apiError)
+			}
+		})
+	}
+}
+
+func TestAnyResponseErr(t *testing.T) {
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"code":0}`)),
+	}
+	diagnostics := diag.Diagnostics{}
+	anyResponse, err := AnyResponseFrom(response, &diagnostics)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := anyResponse.Err(); err != nil {
+		t.Errorf("expected no error for a successful response, got %s", err)
+	}
+}
+
+func TestDeletedResponseFrom(t *testing.T) {
+	cases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedError bool
+	}{
+		{name: "deleted", statusCode: http.StatusOK, body: `{"code":0}`, expectedError: false},
+		{name: "already deleted", statusCode: http.StatusOK, body: `{"code":1001,"message":"Term not found"}`, expectedError: false},
+		{name: "already deleted with http status", statusCode: http.StatusNotFound, body: `{"code":0,"message":"Not found"}`, expectedError: false},
+		{name: "other error", statusCode: http.StatusOK, body: `{"code":2,"message":"Access denied"}`, expectedError: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			response := &http.Response{
+				StatusCode: c.statusCode,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(c.body)),
+			}
+			diagnostics := diag.Diagnostics{}
+			err := DeletedResponseFrom(context.Background(), response, &diagnostics)
+			if (err != nil) != c.expectedError || diagnostics.HasError() != c.expectedError {
+				t.Errorf("expected error %t, got %v %v", c.expectedError, err, diagnostics)
+			}
+		})
+	}
+}